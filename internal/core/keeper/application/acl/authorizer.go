@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package acl
+
+import (
+	"crypto/subtle"
+	"strings"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// Authorizer decides whether a bound token may exercise a capability against a serviceId. A
+// nil *Authorizer, or one created with enabled false, authorizes every request; this lets
+// RegistryController treat ACLs as strictly optional.
+type Authorizer struct {
+	tokenStore    TokenStore
+	masterToken   string
+	defaultPolicy string
+	enabled       bool
+}
+
+// NewAuthorizer creates an Authorizer. defaultPolicy should be constants.ACLDefaultPolicyAllow or
+// constants.ACLDefaultPolicyDeny and governs how a token with no bound policies is treated.
+func NewAuthorizer(tokenStore TokenStore, masterToken string, defaultPolicy string, enabled bool) *Authorizer {
+	return &Authorizer{
+		tokenStore:    tokenStore,
+		masterToken:   masterToken,
+		defaultPolicy: defaultPolicy,
+		enabled:       enabled,
+	}
+}
+
+// Authorized reports whether token may exercise capability against serviceId.
+func (a *Authorizer) Authorized(token string, serviceId string, capability string) bool {
+	if a == nil || !a.enabled {
+		return true
+	}
+	if a.masterToken != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.masterToken)) == 1 {
+		return true
+	}
+
+	policies, exists, err := a.tokenStore.PoliciesForToken(token)
+	if err != nil {
+		// The store couldn't determine whether token is known (e.g. a DB outage), as opposed to
+		// token genuinely having no bound policies; fail closed rather than trusting
+		// DefaultPolicy, since DefaultPolicy=allow would otherwise authorize everything for the
+		// duration of the outage.
+		return false
+	}
+	if !exists {
+		return a.defaultPolicy == constants.ACLDefaultPolicyAllow
+	}
+
+	matched := false
+	for _, policy := range policies {
+		if !strings.HasPrefix(serviceId, policy.ServicePattern) {
+			continue
+		}
+		matched = true
+		for _, granted := range policy.Capabilities {
+			if granted == capability {
+				return true
+			}
+		}
+	}
+	if matched {
+		return false
+	}
+	return a.defaultPolicy == constants.ACLDefaultPolicyAllow
+}
+
+// CanRead reports whether token may read serviceId.
+func (a *Authorizer) CanRead(token string, serviceId string) bool {
+	return a.Authorized(token, serviceId, constants.ACLCapabilityRead)
+}
+
+// CanWrite reports whether token may write serviceId.
+func (a *Authorizer) CanWrite(token string, serviceId string) bool {
+	return a.Authorized(token, serviceId, constants.ACLCapabilityWrite)
+}
+
+// FilterReadable returns the subset of registrationModels that token is allowed to read.
+func (a *Authorizer) FilterReadable(token string, registrationModels []models.Registration) []models.Registration {
+	if a == nil || !a.enabled {
+		return registrationModels
+	}
+
+	filtered := make([]models.Registration, 0, len(registrationModels))
+	for _, registrationModel := range registrationModels {
+		if a.CanRead(token, registrationModel.ServiceId) {
+			filtered = append(filtered, registrationModel)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,75 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package acl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+func TestAuthorizer_Disabled_AllowsEverything(t *testing.T) {
+	authorizer := NewAuthorizer(NewInMemoryTokenStore(), "", constants.ACLDefaultPolicyDeny, false)
+	assert.True(t, authorizer.CanRead("anyToken", "device-1"))
+	assert.True(t, authorizer.CanWrite("anyToken", "device-1"))
+}
+
+func TestAuthorizer_MasterToken_AlwaysAuthorized(t *testing.T) {
+	authorizer := NewAuthorizer(NewInMemoryTokenStore(), "master-token", constants.ACLDefaultPolicyDeny, true)
+	assert.True(t, authorizer.CanWrite("master-token", "anything"))
+}
+
+func TestAuthorizer_PolicyMatching(t *testing.T) {
+	tokenStore := NewInMemoryTokenStore()
+	tokenStore.SetPolicies("device-writer", []models.ACLPolicy{
+		{ServicePattern: "device-", Capabilities: []string{constants.ACLCapabilityWrite, constants.ACLCapabilityRead}},
+	})
+	tokenStore.SetPolicies("read-only", []models.ACLPolicy{
+		{ServicePattern: "", Capabilities: []string{constants.ACLCapabilityRead}},
+	})
+	authorizer := NewAuthorizer(tokenStore, "", constants.ACLDefaultPolicyDeny, true)
+
+	assert.True(t, authorizer.CanWrite("device-writer", "device-1"), "token should be able to write a serviceId matching its ServicePattern")
+	assert.False(t, authorizer.CanWrite("device-writer", "sensor-1"), "token should not be able to write a serviceId outside its ServicePattern")
+	assert.True(t, authorizer.CanRead("read-only", "anything"), "read-only token should be able to read everywhere")
+	assert.False(t, authorizer.CanWrite("read-only", "anything"), "read-only token should not be able to write")
+	assert.False(t, authorizer.CanRead("unknown-token", "anything"), "unknown token should fall back to the default policy")
+}
+
+func TestAuthorizer_DefaultPolicyAllow_UnknownTokenAuthorized(t *testing.T) {
+	authorizer := NewAuthorizer(NewInMemoryTokenStore(), "", constants.ACLDefaultPolicyAllow, true)
+	assert.True(t, authorizer.CanRead("unknown-token", "anything"))
+}
+
+func TestAuthorizer_DefaultPolicyAllow_MatchedPolicyStillDeniesMissingCapability(t *testing.T) {
+	tokenStore := NewInMemoryTokenStore()
+	tokenStore.SetPolicies("read-only", []models.ACLPolicy{
+		{ServicePattern: "", Capabilities: []string{constants.ACLCapabilityRead}},
+	})
+	authorizer := NewAuthorizer(tokenStore, "", constants.ACLDefaultPolicyAllow, true)
+
+	assert.True(t, authorizer.CanRead("read-only", "anything"), "read-only token should be able to read everywhere")
+	assert.False(t, authorizer.CanWrite("read-only", "anything"), "a matched policy must hard-deny capabilities it doesn't grant, even with DefaultPolicy allow")
+}
+
+func TestAuthorizer_FilterReadable(t *testing.T) {
+	tokenStore := NewInMemoryTokenStore()
+	tokenStore.SetPolicies("device-reader", []models.ACLPolicy{
+		{ServicePattern: "device-", Capabilities: []string{constants.ACLCapabilityRead}},
+	})
+	authorizer := NewAuthorizer(tokenStore, "", constants.ACLDefaultPolicyDeny, true)
+
+	registrationModels := []models.Registration{
+		{ServiceId: "device-1"},
+		{ServiceId: "sensor-1"},
+	}
+	filtered := authorizer.FilterReadable("device-reader", registrationModels)
+	assert.Equal(t, 1, len(filtered))
+	assert.Equal(t, "device-1", filtered[0].ServiceId)
+}
@@ -0,0 +1,78 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package acl implements an optional, token-scoped authorization layer for the registry API: a
+// TokenStore resolves the models.ACLPolicy rules bound to a caller's token, and an Authorizer
+// applies those rules to individual serviceIds, in the same spirit as Consul's ACL system.
+package acl
+
+import (
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// TokenStore resolves the models.ACLPolicy rules bound to an ACL token. The second return value
+// is false if the token is not known to the store. The third return value is non-nil if the
+// store could not determine whether the token is known at all (e.g. a DB outage); callers must
+// not treat that the same as "token unknown" and should fail closed instead.
+type TokenStore interface {
+	PoliciesForToken(token string) ([]models.ACLPolicy, bool, error)
+}
+
+// InMemoryTokenStore is a TokenStore backed by a map held in process memory.
+type InMemoryTokenStore struct {
+	mutex    sync.RWMutex
+	policies map[string][]models.ACLPolicy
+}
+
+// NewInMemoryTokenStore creates an empty InMemoryTokenStore.
+func NewInMemoryTokenStore() *InMemoryTokenStore {
+	return &InMemoryTokenStore{policies: make(map[string][]models.ACLPolicy)}
+}
+
+// SetPolicies binds policies to token, replacing any policies previously bound to it.
+func (s *InMemoryTokenStore) SetPolicies(token string, policies []models.ACLPolicy) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.policies[token] = policies
+}
+
+// PoliciesForToken implements TokenStore.
+func (s *InMemoryTokenStore) PoliciesForToken(token string) ([]models.ACLPolicy, bool, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	policies, exists := s.policies[token]
+	return policies, exists, nil
+}
+
+// DBTokenStore is a TokenStore backed by the keeper's persistence layer, for deployments that
+// want ACL tokens to survive a restart.
+type DBTokenStore struct {
+	dbClient interfaces.ACLStore
+}
+
+// NewDBTokenStore creates a DBTokenStore backed by dbClient.
+func NewDBTokenStore(dbClient interfaces.ACLStore) *DBTokenStore {
+	return &DBTokenStore{dbClient: dbClient}
+}
+
+// PoliciesForToken implements TokenStore. A lookup failure of kind KindEntityDoesNotExist means
+// token is genuinely unbound, so it is reported as "not found"; any other error (DB timeout,
+// connection drop, etc.) is propagated so the Authorizer can fail closed instead of mistaking an
+// infrastructure failure for an unbound token.
+func (s *DBTokenStore) PoliciesForToken(token string) ([]models.ACLPolicy, bool, error) {
+	policies, edgeXerr := s.dbClient.ACLPoliciesByToken(token)
+	if edgeXerr != nil {
+		if errors.Kind(edgeXerr) == errors.KindEntityDoesNotExist {
+			return nil, false, nil
+		}
+		return nil, false, edgeXerr
+	}
+	return policies, true, nil
+}
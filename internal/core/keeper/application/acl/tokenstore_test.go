@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package acl
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+func TestDBTokenStore_PoliciesForToken_Found(t *testing.T) {
+	policies := []models.ACLPolicy{{ServicePattern: "device-", Capabilities: []string{"read"}}}
+	dbClientMock := &mocks.ACLStore{}
+	dbClientMock.On("ACLPoliciesByToken", "token").Return(policies, nil)
+
+	store := NewDBTokenStore(dbClientMock)
+	got, exists, err := store.PoliciesForToken("token")
+
+	require.NoError(t, err)
+	assert.True(t, exists)
+	assert.Equal(t, policies, got)
+}
+
+func TestDBTokenStore_PoliciesForToken_NotFound(t *testing.T) {
+	dbClientMock := &mocks.ACLStore{}
+	dbClientMock.On("ACLPoliciesByToken", "unknown").
+		Return(nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+
+	store := NewDBTokenStore(dbClientMock)
+	got, exists, err := store.PoliciesForToken("unknown")
+
+	require.NoError(t, err)
+	assert.False(t, exists)
+	assert.Nil(t, got)
+}
+
+func TestDBTokenStore_PoliciesForToken_PropagatesNonNotFoundErrors(t *testing.T) {
+	dbClientMock := &mocks.ACLStore{}
+	dbClientMock.On("ACLPoliciesByToken", "token").
+		Return(nil, errors.NewCommonEdgeX(errors.KindServerError, "db timeout", nil))
+
+	store := NewDBTokenStore(dbClientMock)
+	got, exists, err := store.PoliciesForToken("token")
+
+	require.Error(t, err, "a non-not-found error must be propagated rather than treated as an unknown token")
+	assert.False(t, exists)
+	assert.Nil(t, got)
+}
+
+func TestAuthorizer_TokenStoreError_FailsClosedRegardlessOfDefaultPolicy(t *testing.T) {
+	dbClientMock := &mocks.ACLStore{}
+	dbClientMock.On("ACLPoliciesByToken", "token").
+		Return(nil, errors.NewCommonEdgeX(errors.KindServerError, "db timeout", nil))
+
+	store := NewDBTokenStore(dbClientMock)
+	authorizer := NewAuthorizer(store, "", constants.ACLDefaultPolicyAllow, true)
+
+	assert.False(t, authorizer.CanRead("token", "anything"), "a TokenStore error must fail closed even with DefaultPolicy=allow")
+	assert.False(t, authorizer.CanWrite("token", "anything"), "a TokenStore error must fail closed even with DefaultPolicy=allow")
+}
@@ -0,0 +1,179 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"time"
+
+	v2Models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// defaultScriptDeadline bounds how long a "script" health check may run when the registration
+// does not specify its own ScriptDeadline.
+const defaultScriptDeadline = 30 * time.Second
+
+// HealthChecker probes a single registered service instance and reports its current status. Each
+// HealthCheck.Type has its own implementation.
+type HealthChecker interface {
+	Check(ctx context.Context, registration models.Registration) v2Models.RegistrationStatus
+}
+
+// NewHealthChecker returns the HealthChecker implementation for the given HealthCheck.Type, or
+// nil if the type is not recognized. enableScriptHealthChecks mirrors
+// Writable.EnableScriptHealthChecks; a "script" type is refused (nil is returned) unless it is
+// set, since ValidateRegistration is expected to have already rejected such registrations.
+func NewHealthChecker(healthCheckType string, ttlTracker *TTLTracker, enableScriptHealthChecks bool) HealthChecker {
+	switch healthCheckType {
+	case constants.HealthCheckTypeHTTP:
+		return httpHealthChecker{}
+	case constants.HealthCheckTypeTCP:
+		return tcpHealthChecker{}
+	case constants.HealthCheckTypeGRPC:
+		return grpcHealthChecker{}
+	case constants.HealthCheckTypeTTL:
+		return ttlHealthChecker{tracker: ttlTracker}
+	case constants.HealthCheckTypeScript:
+		if !enableScriptHealthChecks {
+			return nil
+		}
+		return scriptHealthChecker{}
+	default:
+		return nil
+	}
+}
+
+// httpHealthChecker implements a simple GET-and-check-2xx probe against HealthCheck.Path.
+type httpHealthChecker struct{}
+
+func (httpHealthChecker) Check(ctx context.Context, registration models.Registration) v2Models.RegistrationStatus {
+	url := fmt.Sprintf("http://%s:%d%s", registration.Host, registration.Port, registration.HealthCheck.Path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return v2Models.Down
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return v2Models.Down
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusMultipleChoices {
+		return v2Models.Up
+	}
+	return v2Models.Down
+}
+
+// tcpHealthChecker implements a probe that succeeds if a TCP connection can be established.
+type tcpHealthChecker struct{}
+
+func (tcpHealthChecker) Check(ctx context.Context, registration models.Registration) v2Models.RegistrationStatus {
+	address := registration.HealthCheck.TCPAddress
+	if address == "" {
+		address = fmt.Sprintf("%s:%d", registration.Host, registration.Port)
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return v2Models.Down
+	}
+	_ = conn.Close()
+	return v2Models.Up
+}
+
+// grpcHealthChecker implements a probe using the standard gRPC health checking protocol.
+type grpcHealthChecker struct{}
+
+func (grpcHealthChecker) Check(ctx context.Context, registration models.Registration) v2Models.RegistrationStatus {
+	address := fmt.Sprintf("%s:%d", registration.Host, registration.Port)
+
+	var transportCreds grpc.DialOption
+	if registration.HealthCheck.TLS {
+		transportCreds = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{}))
+	} else {
+		transportCreds = grpc.WithTransportCredentials(insecure.NewCredentials())
+	}
+
+	conn, err := grpc.DialContext(ctx, address, transportCreds, grpc.WithBlock())
+	if err != nil {
+		return v2Models.Down
+	}
+	defer conn.Close()
+
+	resp, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{
+		Service: registration.HealthCheck.GRPCService,
+	})
+	if err != nil || resp.Status != grpc_health_v1.HealthCheckResponse_SERVING {
+		return v2Models.Down
+	}
+	return v2Models.Up
+}
+
+// ttlHealthChecker derives status from how long it has been since the service last heartbeat
+// through the check/ttl endpoint, rather than actively probing the service.
+type ttlHealthChecker struct {
+	tracker *TTLTracker
+}
+
+func (t ttlHealthChecker) Check(_ context.Context, registration models.Registration) v2Models.RegistrationStatus {
+	ttl, err := time.ParseDuration(registration.HealthCheck.TTL)
+	if err != nil {
+		return v2Models.Unknown
+	}
+
+	elapsed, ok := t.tracker.SinceLastHeartbeat(registration.ServiceId)
+	if !ok {
+		return v2Models.Unknown
+	}
+	if elapsed > ttl {
+		return v2Models.Down
+	}
+	if status, ok := t.tracker.LastReportedStatus(registration.ServiceId); ok {
+		return status
+	}
+	return v2Models.Up
+}
+
+// scriptHealthChecker runs an operator-supplied script and treats a zero exit code as healthy.
+type scriptHealthChecker struct{}
+
+func (scriptHealthChecker) Check(ctx context.Context, registration models.Registration) v2Models.RegistrationStatus {
+	if len(registration.HealthCheck.ScriptArgs) == 0 {
+		return v2Models.Unknown
+	}
+
+	deadline := defaultScriptDeadline
+	if registration.HealthCheck.ScriptDeadline != "" {
+		if parsed, err := time.ParseDuration(registration.HealthCheck.ScriptDeadline); err == nil {
+			deadline = parsed
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deadline)
+	defer cancel()
+
+	args := registration.HealthCheck.ScriptArgs
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	if err := cmd.Run(); err != nil {
+		return v2Models.Down
+	}
+	return v2Models.Up
+}
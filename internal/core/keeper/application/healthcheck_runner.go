@@ -0,0 +1,106 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// pollInterval is how often the Runner looks for health checks that are due to run. Each
+// registration's own HealthCheck.Interval still governs how frequently it is actually probed.
+const pollInterval = time.Second
+
+// Runner periodically executes every registered service's configured health check and, when a
+// check's result differs from the Registration's current Status, persists the new Status and
+// publishes it to the Registry.
+type Runner struct {
+	lc                       logger.LoggingClient
+	dbClient                 interfaces.DBClient
+	registry                 interfaces.Registry
+	ttlTracker               *TTLTracker
+	enableScriptHealthChecks bool
+	lastChecked              map[string]time.Time
+}
+
+// NewRunner creates a new Runner. enableScriptHealthChecks mirrors Writable.EnableScriptHealthChecks.
+func NewRunner(lc logger.LoggingClient, dbClient interfaces.DBClient, registry interfaces.Registry, ttlTracker *TTLTracker, enableScriptHealthChecks bool) *Runner {
+	return &Runner{
+		lc:                       lc,
+		dbClient:                 dbClient,
+		registry:                 registry,
+		ttlTracker:               ttlTracker,
+		enableScriptHealthChecks: enableScriptHealthChecks,
+		lastChecked:              make(map[string]time.Time),
+	}
+}
+
+// Run polls for due health checks until ctx is cancelled. It is intended to be started as its own
+// goroutine from the bootstrap handler.
+func (r *Runner) Run(ctx context.Context) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			r.runOnce(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (r *Runner) runOnce(ctx context.Context) {
+	registrations, err := r.dbClient.Registrations()
+	if err != nil {
+		r.lc.Errorf("failed to load registrations for health checking: %v", err)
+		return
+	}
+
+	for _, registration := range registrations {
+		if !r.due(registration) {
+			continue
+		}
+
+		checker := NewHealthChecker(registration.HealthCheck.Type, r.ttlTracker, r.enableScriptHealthChecks)
+		if checker == nil {
+			continue
+		}
+
+		status := checker.Check(ctx, registration)
+		if status == registration.Status {
+			continue
+		}
+
+		registration.Status = status
+		if err := r.dbClient.UpdateRegistration(registration); err != nil {
+			r.lc.Errorf("failed to persist health check status for %s: %v", registration.ServiceId, err)
+			continue
+		}
+		r.registry.Register(registration)
+	}
+}
+
+// due reports whether registration's health check interval has elapsed since it was last run,
+// and if so marks it as checked as of now.
+func (r *Runner) due(registration models.Registration) bool {
+	interval, err := time.ParseDuration(registration.HealthCheck.Interval)
+	if err != nil {
+		return false
+	}
+
+	if last, ok := r.lastChecked[registration.ServiceId]; ok && time.Since(last) < interval {
+		return false
+	}
+	r.lastChecked[registration.ServiceId] = time.Now()
+	return true
+}
@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v2Models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// splitHostPort parses a "host:port" address into the (Host, Port) shape models.Registration
+// expects.
+func splitHostPort(t *testing.T, address string) (string, int) {
+	t.Helper()
+	host, portStr, err := net.SplitHostPort(address)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portStr)
+	require.NoError(t, err)
+	return host, port
+}
+
+func TestHttpHealthChecker_Check(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/healthy" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	host, port := splitHostPort(t, server.Listener.Addr().String())
+
+	checker := httpHealthChecker{}
+
+	up := models.Registration{Host: host, Port: port, HealthCheck: models.HealthCheck{Path: "/healthy"}}
+	assert.Equal(t, v2Models.Up, checker.Check(context.Background(), up), "a 2xx response must report Up")
+
+	down := models.Registration{Host: host, Port: port, HealthCheck: models.HealthCheck{Path: "/unhealthy"}}
+	assert.Equal(t, v2Models.Down, checker.Check(context.Background(), down), "a non-2xx response must report Down")
+}
+
+func TestTcpHealthChecker_Check(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	host, port := splitHostPort(t, listener.Addr().String())
+
+	checker := tcpHealthChecker{}
+	registration := models.Registration{Host: host, Port: port}
+
+	assert.Equal(t, v2Models.Up, checker.Check(context.Background(), registration), "a reachable port must report Up")
+
+	require.NoError(t, listener.Close())
+	assert.Equal(t, v2Models.Down, checker.Check(context.Background(), registration), "a closed port must report Down")
+}
+
+func TestScriptHealthChecker_Check_DeadlineEnforced(t *testing.T) {
+	checker := scriptHealthChecker{}
+	registration := models.Registration{
+		HealthCheck: models.HealthCheck{
+			ScriptArgs:     []string{"sh", "-c", "sleep 5"},
+			ScriptDeadline: "10ms",
+		},
+	}
+
+	start := time.Now()
+	status := checker.Check(context.Background(), registration)
+	elapsed := time.Since(start)
+
+	assert.Equal(t, v2Models.Down, status, "a script still running past its deadline must report Down")
+	assert.Less(t, elapsed, 2*time.Second, "the check must not wait for the full sleep once its deadline has passed")
+}
+
+func TestTtlHealthChecker_Check_ExplicitFailStickyUntilNextHeartbeat(t *testing.T) {
+	tracker := NewTTLTracker()
+	checker := ttlHealthChecker{tracker: tracker}
+	registration := models.Registration{
+		ServiceId:   "ttl-service",
+		HealthCheck: models.HealthCheck{Type: constants.HealthCheckTypeTTL, TTL: "30s"},
+	}
+
+	tracker.Heartbeat(registration.ServiceId, v2Models.Down)
+	assert.Equal(t, v2Models.Down, checker.Check(context.Background(), registration),
+		"an explicitly reported fail must stick even though the TTL has not elapsed")
+
+	tracker.Heartbeat(registration.ServiceId, v2Models.Up)
+	assert.Equal(t, v2Models.Up, checker.Check(context.Background(), registration),
+		"the next heartbeat clears a previously reported fail")
+}
@@ -0,0 +1,66 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+)
+
+// ValidateRegistration checks that a Registration DTO is well-formed before it is persisted or
+// handed to the Registry. It is used by both the Register and UpdateRegister handlers so the two
+// endpoints reject malformed requests the same way. enableScriptHealthChecks mirrors
+// Writable.EnableScriptHealthChecks and must be set for a "script" health check to be accepted.
+func ValidateRegistration(registration dtos.Registration, enableScriptHealthChecks bool) errors.EdgeX {
+	if registration.ServiceId == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "serviceId is empty", nil)
+	}
+
+	healthCheck := registration.HealthCheck
+	if healthCheck.Type == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "healthCheck.type is empty", nil)
+	}
+	if _, err := time.ParseDuration(healthCheck.Interval); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "healthCheck.interval is not a valid duration", err)
+	}
+
+	switch healthCheck.Type {
+	case constants.HealthCheckTypeHTTP:
+		if healthCheck.Path == "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "healthCheck.path is required for http health checks", nil)
+		}
+	case constants.HealthCheckTypeTCP:
+		// healthCheck.tcpAddress is optional; Host:Port is used when it is not set.
+	case constants.HealthCheckTypeGRPC:
+		if healthCheck.GRPCService == "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "healthCheck.grpcService is required for grpc health checks", nil)
+		}
+	case constants.HealthCheckTypeTTL:
+		if _, err := time.ParseDuration(healthCheck.TTL); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "healthCheck.ttl is not a valid duration", err)
+		}
+	case constants.HealthCheckTypeScript:
+		if !enableScriptHealthChecks {
+			return errors.NewCommonEdgeX(errors.KindNotAllowed, "script health checks are disabled; set Writable.EnableScriptHealthChecks to allow them", nil)
+		}
+		if len(healthCheck.ScriptArgs) == 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "healthCheck.scriptArgs is required for script health checks", nil)
+		}
+		if healthCheck.ScriptDeadline != "" {
+			if _, err := time.ParseDuration(healthCheck.ScriptDeadline); err != nil {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, "healthCheck.scriptDeadline is not a valid duration", err)
+			}
+		}
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "healthCheck.type is not supported", nil)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package registry implements the in-memory interfaces.Registry that backs core-keeper's
+// blocking-query and event-stream style APIs, in the same spirit as Consul's agent-local catalog.
+package registry
+
+import (
+	"sync"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// subscriberBuffer is the suggested capacity for a subscriber's channel. publish never blocks: an
+// Event that finds a subscriber's channel full is skipped for that subscriber only, which stays
+// subscribed and receives later Events. The watch handler re-reads the full snapshot on every
+// long-poll cycle, so a skipped Event is reflected in the next response.
+const subscriberBuffer = 16
+
+// Registry is the in-memory, goroutine-safe view of registered services. It tracks a monotonic
+// index that advances on every mutation so blocking-query style clients can detect whether the
+// state has changed since they last observed it.
+type Registry struct {
+	mutex         sync.RWMutex
+	registrations map[string]models.Registration
+	index         uint64
+	subscribers   map[chan<- models.Event]struct{}
+}
+
+// NewRegistry creates a new, empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		registrations: make(map[string]models.Registration),
+		subscribers:   make(map[chan<- models.Event]struct{}),
+	}
+}
+
+// Register adds or updates the in-memory entry for the given Registration and notifies
+// subscribers of the change.
+func (r *Registry) Register(registration models.Registration) {
+	r.mutex.Lock()
+	_, exists := r.registrations[registration.ServiceId]
+	r.index++
+	r.registrations[registration.ServiceId] = registration
+	index := r.index
+	r.mutex.Unlock()
+
+	eventType := models.Add
+	if exists {
+		eventType = models.Update
+	}
+	r.publish(models.Event{Type: eventType, Registration: registration, Index: index})
+}
+
+// DeregisterByServiceId removes the in-memory entry for the given serviceId, if present, and
+// notifies subscribers of the change.
+func (r *Registry) DeregisterByServiceId(serviceId string) {
+	r.mutex.Lock()
+	registration, exists := r.registrations[serviceId]
+	if !exists {
+		r.mutex.Unlock()
+		return
+	}
+	delete(r.registrations, serviceId)
+	r.index++
+	index := r.index
+	r.mutex.Unlock()
+
+	r.publish(models.Event{Type: models.Delete, Registration: registration, Index: index})
+}
+
+// Index returns the current monotonic index of the registry.
+func (r *Registry) Index() uint64 {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	return r.index
+}
+
+// Subscribe registers ch to receive Events as they occur and returns the registry's current
+// index so the caller can tell whether it has already missed changes.
+func (r *Registry) Subscribe(ch chan<- models.Event) uint64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.subscribers[ch] = struct{}{}
+	return r.index
+}
+
+// Unsubscribe removes a channel previously passed to Subscribe.
+func (r *Registry) Unsubscribe(ch chan<- models.Event) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.subscribers, ch)
+}
+
+// publish fans the Event out to every current subscriber, dropping it for any subscriber whose
+// buffer is full rather than blocking the registry on a slow consumer.
+func (r *Registry) publish(event models.Event) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+	for ch := range r.subscribers {
+		select {
+		case ch <- event:
+		default:
+		}
+	}
+}
@@ -0,0 +1,239 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seed
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+	"gopkg.in/yaml.v3"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	v2Models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// registrationsFile is the shape of Writable.SeedRegistrationsFile. It accepts either YAML or
+// JSON since JSON is valid YAML.
+type registrationsFile struct {
+	Registrations []dtos.Registration `yaml:"registrations" json:"registrations"`
+}
+
+// Seeder keeps the registry in sync with the declarative registrations listed in a
+// Writable.SeedRegistrationsFile, giving operators a GitOps-style workflow for edge deployments
+// similar to how other service registries load seed bootstrap data from config. Entries present
+// in the file but missing from storage are added, entries whose spec has drifted are updated, and
+// entries previously sourced from the file but now removed from it are deregistered.
+type Seeder struct {
+	lc                       logger.LoggingClient
+	dbClient                 interfaces.DBClient
+	registry                 interfaces.Registry
+	filePath                 string
+	enableScriptHealthChecks bool
+	reloadMutex              sync.Mutex
+}
+
+// NewSeeder creates a new Seeder. An empty filePath disables seeding: Reload and Run both become
+// no-ops. enableScriptHealthChecks mirrors Writable.EnableScriptHealthChecks and is forwarded to
+// ValidateRegistration for every seeded entry.
+func NewSeeder(lc logger.LoggingClient, dbClient interfaces.DBClient, registry interfaces.Registry, filePath string, enableScriptHealthChecks bool) *Seeder {
+	return &Seeder{
+		lc:                       lc,
+		dbClient:                 dbClient,
+		registry:                 registry,
+		filePath:                 filePath,
+		enableScriptHealthChecks: enableScriptHealthChecks,
+	}
+}
+
+// Reload re-reads the seed file and idempotently upserts each entry through the same validation
+// path used by RegistryController.Register, then deregisters any previously seeded registration
+// that is no longer present in the file. It is safe to call concurrently with itself and is
+// intended to be invoked both on file change and from the POST reload endpoint.
+func (s *Seeder) Reload() errors.EdgeX {
+	if s.filePath == "" {
+		return nil
+	}
+
+	s.reloadMutex.Lock()
+	defer s.reloadMutex.Unlock()
+
+	file, err := s.parseFile()
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool, len(file.Registrations))
+	for _, registrationDTO := range file.Registrations {
+		if registrationDTO.Metadata == nil {
+			registrationDTO.Metadata = make(map[string]string, 1)
+		}
+		registrationDTO.Metadata[constants.SeedSourceMetadataKey] = constants.SeedSourceMetadataValue
+
+		if edgeXerr := application.ValidateRegistration(registrationDTO, s.enableScriptHealthChecks); edgeXerr != nil {
+			s.lc.Errorf("skipping seed registration %s: %v", registrationDTO.ServiceId, edgeXerr)
+			continue
+		}
+
+		if edgeXerr := s.upsert(dtos.ToRegistrationModel(registrationDTO)); edgeXerr != nil {
+			s.lc.Errorf("failed to seed registration %s: %v", registrationDTO.ServiceId, edgeXerr)
+			continue
+		}
+		seen[registrationDTO.ServiceId] = true
+	}
+
+	return s.deregisterRemoved(seen)
+}
+
+// parseFile reads and unmarshals s.filePath. The YAML is first decoded generically and then
+// re-decoded through JSON, so entries use the same camelCase keys as the Registration DTO's json
+// tags (serviceId, healthCheck, ...).
+func (s *Seeder) parseFile() (registrationsFile, errors.EdgeX) {
+	var file registrationsFile
+
+	contents, err := os.ReadFile(s.filePath)
+	if err != nil {
+		return file, errors.NewCommonEdgeX(errors.KindServerError, "failed to read seed registrations file", err)
+	}
+	var raw interface{}
+	if err := yaml.Unmarshal(contents, &raw); err != nil {
+		return file, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse seed registrations file", err)
+	}
+	jsonContents, err := json.Marshal(raw)
+	if err != nil {
+		return file, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse seed registrations file", err)
+	}
+	if err := json.Unmarshal(jsonContents, &file); err != nil {
+		return file, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse seed registrations file", err)
+	}
+	return file, nil
+}
+
+// upsert adds registrationModel if it does not yet exist, updates it if its spec has drifted, or
+// leaves it untouched if it already matches. An added or updated registration is published to the
+// Registry; an untouched one is not.
+func (s *Seeder) upsert(registrationModel models.Registration) errors.EdgeX {
+	existing, edgeXerr := s.dbClient.RegistrationByServiceId(registrationModel.ServiceId)
+	if edgeXerr != nil {
+		if errors.Kind(edgeXerr) != errors.KindEntityDoesNotExist {
+			return edgeXerr
+		}
+
+		registrationModel.Status = v2Models.Unknown
+		added, edgeXerr := s.dbClient.AddRegistration(registrationModel)
+		if edgeXerr != nil {
+			return edgeXerr
+		}
+		s.registry.Register(added)
+		return nil
+	}
+
+	if specEqual(existing, registrationModel) {
+		return nil
+	}
+
+	registrationModel.Status = existing.Status
+	if edgeXerr := s.dbClient.UpdateRegistration(registrationModel); edgeXerr != nil {
+		return edgeXerr
+	}
+	s.registry.Register(registrationModel)
+	return nil
+}
+
+// deregisterRemoved deletes every stored registration tagged as seed-sourced whose serviceId is
+// not in seen.
+func (s *Seeder) deregisterRemoved(seen map[string]bool) errors.EdgeX {
+	registrationModels, edgeXerr := s.dbClient.Registrations()
+	if edgeXerr != nil {
+		return edgeXerr
+	}
+
+	for _, registrationModel := range registrationModels {
+		if registrationModel.Metadata[constants.SeedSourceMetadataKey] != constants.SeedSourceMetadataValue {
+			continue
+		}
+		if seen[registrationModel.ServiceId] {
+			continue
+		}
+
+		if edgeXerr := s.dbClient.DeleteRegistrationByServiceId(registrationModel.ServiceId); edgeXerr != nil {
+			s.lc.Errorf("failed to deregister removed seed registration %s: %v", registrationModel.ServiceId, edgeXerr)
+			continue
+		}
+		s.registry.DeregisterByServiceId(registrationModel.ServiceId)
+	}
+
+	return nil
+}
+
+// specEqual reports whether a and b describe the same desired state, ignoring the
+// runtime-managed Status field.
+func specEqual(a, b models.Registration) bool {
+	a.Status = ""
+	b.Status = ""
+	return reflect.DeepEqual(a, b)
+}
+
+// Run watches filePath for changes, calling Reload once immediately and again on every write,
+// until ctx is cancelled. It is intended to be started as its own goroutine from the bootstrap
+// handler. A Seeder with an empty filePath returns immediately since there is nothing to watch.
+func (s *Seeder) Run(ctx context.Context) {
+	if s.filePath == "" {
+		return
+	}
+
+	if edgeXerr := s.Reload(); edgeXerr != nil {
+		s.lc.Errorf("failed initial seed registrations load: %v", edgeXerr)
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		s.lc.Errorf("failed to watch seed registrations file: %v", err)
+		return
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(filepath.Dir(s.filePath)); err != nil {
+		s.lc.Errorf("failed to watch seed registrations file: %v", err)
+		return
+	}
+
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) != filepath.Clean(s.filePath) {
+				continue
+			}
+			if event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			if edgeXerr := s.Reload(); edgeXerr != nil {
+				s.lc.Errorf("failed to reload seed registrations: %v", edgeXerr)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+			s.lc.Errorf("seed registrations file watcher error: %v", err)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
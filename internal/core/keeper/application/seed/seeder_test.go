@@ -0,0 +1,202 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seed
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	v2Models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/infrastructure/interfaces/mocks"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+func writeSeedFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "seed.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestSeeder_Reload_AddsMissingRegistration(t *testing.T) {
+	path := writeSeedFile(t, `
+registrations:
+  - serviceId: device-a
+    host: 127.0.0.1
+    port: 8080
+    healthCheck:
+      type: tcp
+      interval: 10s
+`)
+
+	dbClientMock := &mocks.DBClient{}
+	registryMock := &mocks.Registry{}
+
+	dbClientMock.On("RegistrationByServiceId", "device-a").
+		Return(models.Registration{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
+	dbClientMock.On("AddRegistration", mock.MatchedBy(func(r models.Registration) bool {
+		return r.ServiceId == "device-a" && r.Metadata[constants.SeedSourceMetadataKey] == constants.SeedSourceMetadataValue
+	})).Return(models.Registration{ServiceId: "device-a"}, nil)
+	registryMock.On("Register", mock.Anything)
+	dbClientMock.On("Registrations").Return([]models.Registration{}, nil)
+
+	seeder := NewSeeder(logger.NewMockClient(), dbClientMock, registryMock, path, false)
+	edgeXerr := seeder.Reload()
+
+	require.Nil(t, edgeXerr)
+	dbClientMock.AssertExpectations(t)
+	registryMock.AssertExpectations(t)
+}
+
+func TestSeeder_Reload_UpdatesDriftedRegistration(t *testing.T) {
+	path := writeSeedFile(t, `
+registrations:
+  - serviceId: device-a
+    host: 10.0.0.2
+    port: 9090
+    healthCheck:
+      type: tcp
+      interval: 10s
+`)
+
+	existing := models.Registration{
+		ServiceId: "device-a",
+		Host:      "10.0.0.1",
+		Port:      8080,
+		HealthCheck: models.HealthCheck{
+			Type:     constants.HealthCheckTypeTCP,
+			Interval: "10s",
+		},
+		Status:   v2Models.Up,
+		Metadata: map[string]string{constants.SeedSourceMetadataKey: constants.SeedSourceMetadataValue},
+	}
+
+	dbClientMock := &mocks.DBClient{}
+	registryMock := &mocks.Registry{}
+
+	dbClientMock.On("RegistrationByServiceId", "device-a").Return(existing, nil)
+	dbClientMock.On("UpdateRegistration", mock.MatchedBy(func(r models.Registration) bool {
+		return r.ServiceId == "device-a" && r.Host == "10.0.0.2" && r.Port == 9090 && r.Status == v2Models.Up
+	})).Return(nil)
+	registryMock.On("Register", mock.Anything)
+	dbClientMock.On("Registrations").Return([]models.Registration{existing}, nil)
+
+	seeder := NewSeeder(logger.NewMockClient(), dbClientMock, registryMock, path, false)
+	edgeXerr := seeder.Reload()
+
+	require.Nil(t, edgeXerr)
+	dbClientMock.AssertExpectations(t)
+	registryMock.AssertExpectations(t)
+}
+
+func TestSeeder_Reload_LeavesUnchangedRegistrationAlone(t *testing.T) {
+	path := writeSeedFile(t, `
+registrations:
+  - serviceId: device-a
+    host: 10.0.0.1
+    port: 8080
+    healthCheck:
+      type: tcp
+      interval: 10s
+`)
+
+	existing := models.Registration{
+		ServiceId: "device-a",
+		Host:      "10.0.0.1",
+		Port:      8080,
+		HealthCheck: models.HealthCheck{
+			Type:     constants.HealthCheckTypeTCP,
+			Interval: "10s",
+		},
+		Status:   v2Models.Up,
+		Metadata: map[string]string{constants.SeedSourceMetadataKey: constants.SeedSourceMetadataValue},
+	}
+
+	dbClientMock := &mocks.DBClient{}
+	registryMock := &mocks.Registry{}
+
+	dbClientMock.On("RegistrationByServiceId", "device-a").Return(existing, nil)
+	dbClientMock.On("Registrations").Return([]models.Registration{existing}, nil)
+
+	seeder := NewSeeder(logger.NewMockClient(), dbClientMock, registryMock, path, false)
+	edgeXerr := seeder.Reload()
+
+	require.Nil(t, edgeXerr)
+	dbClientMock.AssertExpectations(t)
+	dbClientMock.AssertNotCalled(t, "UpdateRegistration", mock.Anything)
+	registryMock.AssertNotCalled(t, "Register", mock.Anything)
+}
+
+func TestSeeder_Reload_DeregistersRemovedSeedEntry(t *testing.T) {
+	path := writeSeedFile(t, `registrations: []`)
+
+	stale := models.Registration{
+		ServiceId: "device-old",
+		Metadata:  map[string]string{constants.SeedSourceMetadataKey: constants.SeedSourceMetadataValue},
+	}
+	handWritten := models.Registration{
+		ServiceId: "device-manual",
+	}
+
+	dbClientMock := &mocks.DBClient{}
+	registryMock := &mocks.Registry{}
+
+	dbClientMock.On("Registrations").Return([]models.Registration{stale, handWritten}, nil)
+	dbClientMock.On("DeleteRegistrationByServiceId", "device-old").Return(nil)
+	registryMock.On("DeregisterByServiceId", "device-old")
+
+	seeder := NewSeeder(logger.NewMockClient(), dbClientMock, registryMock, path, false)
+	edgeXerr := seeder.Reload()
+
+	require.Nil(t, edgeXerr)
+	dbClientMock.AssertExpectations(t)
+	registryMock.AssertExpectations(t)
+	dbClientMock.AssertNotCalled(t, "DeleteRegistrationByServiceId", "device-manual")
+}
+
+func TestSeeder_Reload_EmptyFilePathIsNoOp(t *testing.T) {
+	dbClientMock := &mocks.DBClient{}
+	registryMock := &mocks.Registry{}
+
+	seeder := NewSeeder(logger.NewMockClient(), dbClientMock, registryMock, "", false)
+	edgeXerr := seeder.Reload()
+
+	require.Nil(t, edgeXerr)
+	dbClientMock.AssertExpectations(t)
+	registryMock.AssertExpectations(t)
+}
+
+func TestSeeder_Reload_SkipsInvalidEntry(t *testing.T) {
+	path := writeSeedFile(t, `
+registrations:
+  - serviceId: ""
+    host: 10.0.0.1
+    port: 8080
+    healthCheck:
+      type: tcp
+      interval: 10s
+`)
+
+	dbClientMock := &mocks.DBClient{}
+	registryMock := &mocks.Registry{}
+
+	dbClientMock.On("Registrations").Return([]models.Registration{}, nil)
+
+	seeder := NewSeeder(logger.NewMockClient(), dbClientMock, registryMock, path, false)
+	edgeXerr := seeder.Reload()
+
+	require.Nil(t, edgeXerr)
+	dbClientMock.AssertExpectations(t)
+	dbClientMock.AssertNotCalled(t, "AddRegistration", mock.Anything)
+}
@@ -0,0 +1,59 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package application
+
+import (
+	"sync"
+	"time"
+
+	v2Models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// TTLTracker records the most recent heartbeat time and self-reported status for each "ttl"
+// health check so the Runner can tell whether a service has gone quiet for longer than its
+// configured TTL, without discarding an explicitly reported fail/warn in the meantime.
+type TTLTracker struct {
+	mutex        sync.Mutex
+	lastBeats    map[string]time.Time
+	lastStatuses map[string]v2Models.RegistrationStatus
+}
+
+// NewTTLTracker creates an empty TTLTracker.
+func NewTTLTracker() *TTLTracker {
+	return &TTLTracker{
+		lastBeats:    make(map[string]time.Time),
+		lastStatuses: make(map[string]v2Models.RegistrationStatus),
+	}
+}
+
+// Heartbeat records that serviceId was just heard from and reported status.
+func (t *TTLTracker) Heartbeat(serviceId string, status v2Models.RegistrationStatus) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.lastBeats[serviceId] = time.Now()
+	t.lastStatuses[serviceId] = status
+}
+
+// SinceLastHeartbeat returns how long it has been since serviceId's last heartbeat. The second
+// return value is false if no heartbeat has ever been recorded for serviceId.
+func (t *TTLTracker) SinceLastHeartbeat(serviceId string) (time.Duration, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	last, ok := t.lastBeats[serviceId]
+	if !ok {
+		return 0, false
+	}
+	return time.Since(last), true
+}
+
+// LastReportedStatus returns the status serviceId reported with its last heartbeat. The second
+// return value is false if no heartbeat has ever been recorded for serviceId.
+func (t *TTLTracker) LastReportedStatus(serviceId string) (v2Models.RegistrationStatus, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	status, ok := t.lastStatuses[serviceId]
+	return status, ok
+}
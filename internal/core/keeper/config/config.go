@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v2/config"
+)
+
+// ConfigurationStruct contains the configuration properties for core-keeper.
+type ConfigurationStruct struct {
+	Writable WritableInfo
+	Service  bootstrapConfig.ServiceInfo
+	Database bootstrapConfig.Database
+	Registry bootstrapConfig.RegistryInfo
+}
+
+// WritableInfo contains configuration properties that can be changed at runtime.
+type WritableInfo struct {
+	LogLevel string
+	ACL      ACLInfo
+	// SeedRegistrationsFile is the path to a YAML or JSON file containing a declarative list of
+	// registrations that the seed.Seeder keeps in sync with the registry. Empty disables seeding.
+	SeedRegistrationsFile string
+	// EnableScriptHealthChecks allows registrations to use a "script" health check, which runs an
+	// arbitrary executable with caller-supplied arguments on the keeper host. This is remote code
+	// execution by design, so it defaults to false; operators must opt in, the same way Consul's
+	// enable_script_checks is off by default.
+	EnableScriptHealthChecks bool
+}
+
+// ACLInfo contains the settings for the registry's optional token-scoped ACL subsystem. The
+// master token itself is not configured here; it is read from the secret store.
+type ACLInfo struct {
+	// Enabled turns ACL enforcement on for every registry endpoint. When false, every request is
+	// authorized regardless of DefaultPolicy or bound token policies.
+	Enabled bool
+	// DefaultPolicy governs how a token with no matching models.ACLPolicy is treated: "allow" or
+	// "deny".
+	DefaultPolicy string
+}
+
+// UpdateFromRaw converts configuration received from the registry to a known type which is then used to overwrite
+// the service's existing configuration.
+func (c *ConfigurationStruct) UpdateFromRaw(rawConfig interface{}) bool {
+	configuration, ok := rawConfig.(*ConfigurationStruct)
+	if ok {
+		*c = *configuration
+	}
+	return ok
+}
+
+// EmptyWritablePtr returns a pointer to a new empty WritableInfo struct to be used to hold the configuration
+// when it is retrieved from the registry.
+func (c *ConfigurationStruct) EmptyWritablePtr() interface{} {
+	return &WritableInfo{}
+}
+
+// GetWritablePtr returns a pointer to the writable section of the configuration.
+func (c *ConfigurationStruct) GetWritablePtr() interface{} {
+	return &c.Writable
+}
+
+// GetBootstrap returns the configuration elements required by the bootstrap.
+func (c *ConfigurationStruct) GetBootstrap() bootstrapConfig.BootstrapConfiguration {
+	return bootstrapConfig.BootstrapConfiguration{
+		Service:  &c.Service,
+		Registry: &c.Registry,
+	}
+}
+
+// GetLogLevel returns the current log level.
+func (c *ConfigurationStruct) GetLogLevel() string {
+	return c.Writable.LogLevel
+}
+
+// GetRegistryInfo returns the RegistryInfo section of the configuration.
+func (c *ConfigurationStruct) GetRegistryInfo() bootstrapConfig.RegistryInfo {
+	return c.Registry
+}
+
+// GetInsecureSecrets returns the InsecureSecrets configured for the service, if any.
+func (c *ConfigurationStruct) GetInsecureSecrets() bootstrapConfig.InsecureSecrets {
+	return nil
+}
+
+// GetTelemetryInfo returns the TelemetryInfo section of the configuration.
+func (c *ConfigurationStruct) GetTelemetryInfo() *bootstrapConfig.TelemetryInfo {
+	return nil
+}
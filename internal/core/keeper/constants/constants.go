@@ -0,0 +1,97 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package constants
+
+import (
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/common"
+)
+
+// Registry API route constants
+const (
+	ServiceId = "serviceId"
+
+	ApiRegisterRoute                = common.ApiVersion + "/registry/register"
+	ApiAllRegistrationsRoute        = common.ApiVersion + "/registry/registrations"
+	ApiRegistrationByServiceIdRoute = common.ApiVersion + "/registry/registrations/{" + ServiceId + "}"
+	ApiRegistryWatchRoute           = common.ApiVersion + "/registry/watch"
+	ApiRegistryDNSRoute             = common.ApiVersion + "/registry/srv/{" + ServiceId + "}"
+	ApiRegistryReloadRoute          = common.ApiVersion + "/registry/reload"
+
+	// CheckStatus is the mux variable name for the status path segment of ApiCheckTTLRoute.
+	CheckStatus      = "status"
+	ApiCheckTTLRoute = common.ApiVersion + "/registry/check/ttl/{" + ServiceId + "}/{" + CheckStatus + "}"
+
+	// WatchWaitQueryParam and WatchIndexQueryParam are the blocking-query parameters accepted by
+	// ApiRegistryWatchRoute, mirroring Consul's ?wait=&index= convention.
+	WatchWaitQueryParam  = "wait"
+	WatchIndexQueryParam = "index"
+	// WatchServiceIdPrefixQueryParam restricts a watch to registrations whose serviceId begins
+	// with the given prefix.
+	WatchServiceIdPrefixQueryParam = "servicePrefix"
+
+	// RegistryIndexHeader carries the registry's current index on watch responses, mirroring
+	// Consul's X-Consul-Index header.
+	RegistryIndexHeader = "X-Registry-Index"
+
+	// DefaultWatchWait is used when a watch request omits ?wait=.
+	DefaultWatchWait = 60 * time.Second
+	// MaxWatchWait caps how long a single watch request may block.
+	MaxWatchWait = 10 * time.Minute
+
+	// TagQueryParam filters registrations to those carrying the given tag. It may be repeated to
+	// require more than one tag, and is accepted by both ApiAllRegistrationsRoute and
+	// ApiRegistryDNSRoute.
+	TagQueryParam = "tag"
+	// StatusQueryParam filters ApiAllRegistrationsRoute to registrations with the given status.
+	StatusQueryParam = "status"
+	// MetadataQueryParamPrefix filters ApiAllRegistrationsRoute to registrations whose Metadata
+	// entry for the trailing key equals the query value, e.g. ?metadata.region=us-east.
+	MetadataQueryParamPrefix = "metadata."
+)
+
+// Health check types supported by Registration.HealthCheck.Type.
+const (
+	HealthCheckTypeHTTP   = "http"
+	HealthCheckTypeTCP    = "tcp"
+	HealthCheckTypeGRPC   = "grpc"
+	HealthCheckTypeTTL    = "ttl"
+	HealthCheckTypeScript = "script"
+)
+
+// Check statuses accepted by ApiCheckTTLRoute, matching Consul's pass/warn/fail vocabulary.
+const (
+	CheckStatusPass = "pass"
+	CheckStatusWarn = "warn"
+	CheckStatusFail = "fail"
+)
+
+// ACLTokenHeader carries the caller's bound ACL token on registry requests, mirroring Consul's
+// X-Consul-Token header.
+const ACLTokenHeader = "X-Registry-Token"
+
+// Capabilities an models.ACLPolicy may grant.
+const (
+	ACLCapabilityRead  = "read"
+	ACLCapabilityWrite = "write"
+)
+
+// Values accepted by config.ACLInfo.DefaultPolicy, controlling how a token with no matching
+// policy is treated.
+const (
+	ACLDefaultPolicyAllow = "allow"
+	ACLDefaultPolicyDeny  = "deny"
+)
+
+// SeedSourceMetadataKey and SeedSourceMetadataValue mark a Registration as having been created by
+// the seed.Seeder from Writable.SeedRegistrationsFile, rather than through the register APIs
+// directly, so it can be told apart from a registration an operator or service created by hand
+// once it is removed from the file.
+const (
+	SeedSourceMetadataKey   = "source"
+	SeedSourceMetadataValue = "seed"
+)
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application/acl"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application/seed"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/config"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/infrastructure/interfaces"
+)
+
+// ConfigurationName contains the name of the core-keeper's config.ConfigurationStruct implementation in the DIC.
+var ConfigurationName = di.TypeInstanceToName(config.ConfigurationStruct{})
+
+// DBClientInterfaceName contains the name of the interfaces.DBClient implementation in the DIC.
+var DBClientInterfaceName = di.TypeInstanceToName((*interfaces.DBClient)(nil))
+
+// RegistryInterfaceName contains the name of the interfaces.Registry implementation in the DIC.
+var RegistryInterfaceName = di.TypeInstanceToName((*interfaces.Registry)(nil))
+
+// TTLTrackerName contains the name of the application.TTLTracker instance in the DIC.
+var TTLTrackerName = di.TypeInstanceToName(application.TTLTracker{})
+
+// ACLAuthorizerName contains the name of the acl.Authorizer instance in the DIC.
+var ACLAuthorizerName = di.TypeInstanceToName(acl.Authorizer{})
+
+// SeederName contains the name of the seed.Seeder instance in the DIC.
+var SeederName = di.TypeInstanceToName(seed.Seeder{})
+
+// ConfigurationFrom helper function queries the DIC and returns the core-keeper's configuration.
+func ConfigurationFrom(get di.Get) *config.ConfigurationStruct {
+	return get(ConfigurationName).(*config.ConfigurationStruct)
+}
+
+// DBClientFrom helper function queries the DIC and returns the interfaces.DBClient implementation.
+func DBClientFrom(get di.Get) interfaces.DBClient {
+	return get(DBClientInterfaceName).(interfaces.DBClient)
+}
+
+// RegistryFrom helper function queries the DIC and returns the interfaces.Registry implementation.
+func RegistryFrom(get di.Get) interfaces.Registry {
+	return get(RegistryInterfaceName).(interfaces.Registry)
+}
+
+// TTLTrackerFrom helper function queries the DIC and returns the application.TTLTracker instance.
+func TTLTrackerFrom(get di.Get) *application.TTLTracker {
+	return get(TTLTrackerName).(*application.TTLTracker)
+}
+
+// ACLAuthorizerFrom helper function queries the DIC and returns the acl.Authorizer instance. A
+// nil *acl.Authorizer authorizes every request, so this is safe to call even when ACLs are
+// disabled.
+func ACLAuthorizerFrom(get di.Get) *acl.Authorizer {
+	authorizer, ok := get(ACLAuthorizerName).(*acl.Authorizer)
+	if !ok {
+		return nil
+	}
+	return authorizer
+}
+
+// SeederFrom helper function queries the DIC and returns the seed.Seeder instance.
+func SeederFrom(get di.Get) *seed.Seeder {
+	return get(SeederName).(*seed.Seeder)
+}
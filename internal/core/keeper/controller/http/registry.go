@@ -0,0 +1,528 @@
+//
+// Copyright (C) 2022-2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	v2Models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/gorilla/mux"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application/acl"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos/requests"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos/responses"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/infrastructure/interfaces"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// RegistryController handles the HTTP layer of the service registry API.
+type RegistryController struct {
+	dic *di.Container
+}
+
+// NewRegistryController creates a new RegistryController.
+func NewRegistryController(dic *di.Container) *RegistryController {
+	return &RegistryController{dic: dic}
+}
+
+// Register registers a new service instance.
+func (c *RegistryController) Register(w http.ResponseWriter, r *http.Request) {
+	lc := bootstrapContainer.LoggingClientFrom(c.dic.Get)
+	dbClient := container.DBClientFrom(c.dic.Get)
+	registry := container.RegistryFrom(c.dic.Get)
+	authorizer := container.ACLAuthorizerFrom(c.dic.Get)
+	config := container.ConfigurationFrom(c.dic.Get)
+
+	var req requests.AddRegistrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeErrorResponse(lc, w, req.RequestId, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode request body", err))
+		return
+	}
+
+	if edgeXerr := application.ValidateRegistration(req.Registration, config.Writable.EnableScriptHealthChecks); edgeXerr != nil {
+		writeErrorResponse(lc, w, req.RequestId, edgeXerr)
+		return
+	}
+
+	if !authorizer.CanWrite(r.Header.Get(constants.ACLTokenHeader), req.Registration.ServiceId) {
+		writeErrorResponse(lc, w, req.RequestId, errors.NewCommonEdgeX(errors.KindNotAllowed, "token is not authorized to write this serviceId", nil))
+		return
+	}
+
+	registrationModel := dtos.ToRegistrationModel(req.Registration)
+	if registrationModel.Status == "" {
+		registrationModel.Status = v2Models.Unknown
+	}
+
+	addedRegistration, edgeXerr := dbClient.AddRegistration(registrationModel)
+	if edgeXerr != nil {
+		writeErrorResponse(lc, w, req.RequestId, edgeXerr)
+		return
+	}
+	registry.Register(addedRegistration)
+
+	writeResponse(lc, w, http.StatusCreated, commonDTO.NewBaseResponse(req.RequestId, "", http.StatusCreated))
+}
+
+// UpdateRegister updates an existing service instance's registration.
+func (c *RegistryController) UpdateRegister(w http.ResponseWriter, r *http.Request) {
+	lc := bootstrapContainer.LoggingClientFrom(c.dic.Get)
+	dbClient := container.DBClientFrom(c.dic.Get)
+	registry := container.RegistryFrom(c.dic.Get)
+	authorizer := container.ACLAuthorizerFrom(c.dic.Get)
+	config := container.ConfigurationFrom(c.dic.Get)
+
+	var req requests.AddRegistrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeErrorResponse(lc, w, req.RequestId, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode request body", err))
+		return
+	}
+
+	if edgeXerr := application.ValidateRegistration(req.Registration, config.Writable.EnableScriptHealthChecks); edgeXerr != nil {
+		writeErrorResponse(lc, w, req.RequestId, edgeXerr)
+		return
+	}
+
+	if !authorizer.CanWrite(r.Header.Get(constants.ACLTokenHeader), req.Registration.ServiceId) {
+		writeErrorResponse(lc, w, req.RequestId, errors.NewCommonEdgeX(errors.KindNotAllowed, "token is not authorized to write this serviceId", nil))
+		return
+	}
+
+	registrationModel := dtos.ToRegistrationModel(req.Registration)
+	if registrationModel.Status == "" {
+		registrationModel.Status = v2Models.Unknown
+	}
+
+	if edgeXerr := dbClient.UpdateRegistration(registrationModel); edgeXerr != nil {
+		writeErrorResponse(lc, w, req.RequestId, edgeXerr)
+		return
+	}
+
+	registry.Register(registrationModel)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Deregister removes a service instance's registration. When ACLs are enabled, router.go wraps
+// this handler in acl.RequireCapability, which rejects writes the bound token cannot make before
+// Deregister ever runs.
+func (c *RegistryController) Deregister(w http.ResponseWriter, r *http.Request) {
+	lc := bootstrapContainer.LoggingClientFrom(c.dic.Get)
+	dbClient := container.DBClientFrom(c.dic.Get)
+	registry := container.RegistryFrom(c.dic.Get)
+
+	serviceId := mux.Vars(r)[constants.ServiceId]
+	if serviceId == "" {
+		writeErrorResponse(lc, w, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "serviceId is empty", nil))
+		return
+	}
+
+	if edgeXerr := dbClient.DeleteRegistrationByServiceId(serviceId); edgeXerr != nil {
+		writeErrorResponse(lc, w, "", edgeXerr)
+		return
+	}
+	registry.DeregisterByServiceId(serviceId)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RegistrationByServiceId returns the registration for the given serviceId. If ACLs are enabled
+// and the bound token cannot read serviceId, it is reported as not found rather than forbidden,
+// so the endpoint does not confirm the existence of registrations the caller has no visibility
+// into.
+func (c *RegistryController) RegistrationByServiceId(w http.ResponseWriter, r *http.Request) {
+	lc := bootstrapContainer.LoggingClientFrom(c.dic.Get)
+	dbClient := container.DBClientFrom(c.dic.Get)
+	authorizer := container.ACLAuthorizerFrom(c.dic.Get)
+
+	serviceId := mux.Vars(r)[constants.ServiceId]
+	if serviceId == "" {
+		writeErrorResponse(lc, w, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "serviceId is empty", nil))
+		return
+	}
+
+	if !authorizer.CanRead(r.Header.Get(constants.ACLTokenHeader), serviceId) {
+		writeErrorResponse(lc, w, "", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "registration not found", nil))
+		return
+	}
+
+	registrationModel, edgeXerr := dbClient.RegistrationByServiceId(serviceId)
+	if edgeXerr != nil {
+		writeErrorResponse(lc, w, "", edgeXerr)
+		return
+	}
+
+	response := responses.NewRegistrationResponse("", "", http.StatusOK, dtos.FromRegistrationModelToDTO(registrationModel))
+	writeResponse(lc, w, http.StatusOK, response)
+}
+
+// Registrations returns every known service registration, optionally narrowed by the ?tag=,
+// ?status=, and ?metadata.key=value query parameters, which are ANDed together. When ACLs are
+// enabled, entries the bound token cannot read are silently dropped from the result.
+func (c *RegistryController) Registrations(w http.ResponseWriter, r *http.Request) {
+	lc := bootstrapContainer.LoggingClientFrom(c.dic.Get)
+	dbClient := container.DBClientFrom(c.dic.Get)
+	authorizer := container.ACLAuthorizerFrom(c.dic.Get)
+
+	registrationModels, edgeXerr := dbClient.Registrations()
+	if edgeXerr != nil {
+		writeErrorResponse(lc, w, "", edgeXerr)
+		return
+	}
+	registrationModels = authorizer.FilterReadable(r.Header.Get(constants.ACLTokenHeader), registrationModels)
+
+	queryParams := r.URL.Query()
+	tags := queryParams[constants.TagQueryParam]
+	status := queryParams.Get(constants.StatusQueryParam)
+	metadata := metadataFilterFromQuery(queryParams)
+
+	filteredModels := make([]models.Registration, 0, len(registrationModels))
+	for _, registrationModel := range registrationModels {
+		if registrationMatchesFilter(registrationModel, tags, status, metadata) {
+			filteredModels = append(filteredModels, registrationModel)
+		}
+	}
+
+	response := responses.NewMultiRegistrationsResponse("", "", http.StatusOK, uint32(len(filteredModels)), toRegistrationDTOs(filteredModels, ""))
+	writeResponse(lc, w, http.StatusOK, response)
+}
+
+// DNSQuery returns RFC 2782-style SRV records for every Up registration whose serviceId exactly
+// matches the requested serviceId, optionally narrowed by one or more ?tag= query parameters. This
+// mirrors the lookup Consul exposes at /v1/catalog/service/{service}, which is also an exact-name
+// match, letting edge clients resolve service instances without running a sidecar DNS server. When
+// ACLs are enabled, entries the bound token cannot read are excluded, the same as Registrations.
+func (c *RegistryController) DNSQuery(w http.ResponseWriter, r *http.Request) {
+	lc := bootstrapContainer.LoggingClientFrom(c.dic.Get)
+	dbClient := container.DBClientFrom(c.dic.Get)
+	authorizer := container.ACLAuthorizerFrom(c.dic.Get)
+
+	serviceId := mux.Vars(r)[constants.ServiceId]
+	if serviceId == "" {
+		writeErrorResponse(lc, w, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "serviceId is empty", nil))
+		return
+	}
+
+	registrationModels, edgeXerr := dbClient.Registrations()
+	if edgeXerr != nil {
+		writeErrorResponse(lc, w, "", edgeXerr)
+		return
+	}
+
+	token := r.Header.Get(constants.ACLTokenHeader)
+	tags := r.URL.Query()[constants.TagQueryParam]
+
+	records := make([]dtos.SRVRecord, 0)
+	for _, registrationModel := range registrationModels {
+		if registrationModel.ServiceId != serviceId {
+			continue
+		}
+		if !authorizer.CanRead(token, registrationModel.ServiceId) {
+			continue
+		}
+		if registrationModel.Status != v2Models.Up {
+			continue
+		}
+		if !registrationMatchesFilter(registrationModel, tags, "", nil) {
+			continue
+		}
+		records = append(records, dtos.SRVRecord{
+			Target:   registrationModel.Host,
+			Port:     registrationModel.Port,
+			Weight:   1,
+			Priority: 1,
+		})
+	}
+
+	response := responses.NewMultiSRVRecordsResponse("", "", http.StatusOK, uint32(len(records)), records)
+	writeResponse(lc, w, http.StatusOK, response)
+}
+
+// CheckTTL lets a service with a "ttl" health check report its own status, mirroring Consul's
+// agent check pass/warn/fail endpoints. Reporting any status, including "fail", counts as a
+// heartbeat and resets the TTL clock tracked by the Runner.
+func (c *RegistryController) CheckTTL(w http.ResponseWriter, r *http.Request) {
+	lc := bootstrapContainer.LoggingClientFrom(c.dic.Get)
+	dbClient := container.DBClientFrom(c.dic.Get)
+	registry := container.RegistryFrom(c.dic.Get)
+	ttlTracker := container.TTLTrackerFrom(c.dic.Get)
+	authorizer := container.ACLAuthorizerFrom(c.dic.Get)
+
+	vars := mux.Vars(r)
+	serviceId := vars[constants.ServiceId]
+	if serviceId == "" {
+		writeErrorResponse(lc, w, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "serviceId is empty", nil))
+		return
+	}
+
+	if !authorizer.CanWrite(r.Header.Get(constants.ACLTokenHeader), serviceId) {
+		writeErrorResponse(lc, w, "", errors.NewCommonEdgeX(errors.KindNotAllowed, "token is not authorized to write this serviceId", nil))
+		return
+	}
+
+	newStatus, edgeXerr := ttlStatusFromCheckStatus(vars[constants.CheckStatus])
+	if edgeXerr != nil {
+		writeErrorResponse(lc, w, "", edgeXerr)
+		return
+	}
+
+	registrationModel, edgeXerr := dbClient.RegistrationByServiceId(serviceId)
+	if edgeXerr != nil {
+		writeErrorResponse(lc, w, "", edgeXerr)
+		return
+	}
+	if registrationModel.HealthCheck.Type != constants.HealthCheckTypeTTL {
+		writeErrorResponse(lc, w, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "serviceId is not registered with a ttl health check", nil))
+		return
+	}
+
+	ttlTracker.Heartbeat(serviceId, newStatus)
+	registrationModel.Status = newStatus
+	if edgeXerr := dbClient.UpdateRegistration(registrationModel); edgeXerr != nil {
+		writeErrorResponse(lc, w, "", edgeXerr)
+		return
+	}
+	registry.Register(registrationModel)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Reload re-reads Writable.SeedRegistrationsFile and reconciles the registry with it, mirroring
+// what the seed.Seeder already does on file change. router.go restricts this endpoint to tokens
+// holding a registry-wide write policy, since it is not scoped to a single serviceId.
+func (c *RegistryController) Reload(w http.ResponseWriter, r *http.Request) {
+	lc := bootstrapContainer.LoggingClientFrom(c.dic.Get)
+	seeder := container.SeederFrom(c.dic.Get)
+
+	if edgeXerr := seeder.Reload(); edgeXerr != nil {
+		writeErrorResponse(lc, w, "", edgeXerr)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ttlStatusFromCheckStatus maps a check/ttl status path segment to the RegistrationStatus it
+// represents.
+func ttlStatusFromCheckStatus(status string) (v2Models.RegistrationStatus, errors.EdgeX) {
+	switch status {
+	case constants.CheckStatusPass:
+		return v2Models.Up, nil
+	case constants.CheckStatusWarn:
+		return v2Models.Unknown, nil
+	case constants.CheckStatusFail:
+		return v2Models.Down, nil
+	default:
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported ttl check status %q", status), nil)
+	}
+}
+
+// Watch implements a Consul-style blocking query (or, when the client sends
+// "Accept: text/event-stream", a Server-Sent-Events stream) over the registry's state. Clients
+// supply the last index they observed via ?index= and the request holds until the registry's
+// state advances past it or ?wait= elapses, whichever comes first. When ACLs are enabled, entries
+// and events the bound token cannot read are excluded, the same as Registrations.
+func (c *RegistryController) Watch(w http.ResponseWriter, r *http.Request) {
+	lc := bootstrapContainer.LoggingClientFrom(c.dic.Get)
+	dbClient := container.DBClientFrom(c.dic.Get)
+	registry := container.RegistryFrom(c.dic.Get)
+	authorizer := container.ACLAuthorizerFrom(c.dic.Get)
+	token := r.Header.Get(constants.ACLTokenHeader)
+
+	queryParams := r.URL.Query()
+	servicePrefix := queryParams.Get(constants.WatchServiceIdPrefixQueryParam)
+
+	if strings.Contains(r.Header.Get("Accept"), "text/event-stream") {
+		c.watchSSE(w, r, registry, authorizer, token, servicePrefix)
+		return
+	}
+
+	wait := constants.DefaultWatchWait
+	if waitParam := queryParams.Get(constants.WatchWaitQueryParam); waitParam != "" {
+		parsed, err := time.ParseDuration(waitParam)
+		if err != nil {
+			writeErrorResponse(lc, w, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid wait duration", err))
+			return
+		}
+		wait = parsed
+	}
+	if wait > constants.MaxWatchWait {
+		wait = constants.MaxWatchWait
+	}
+
+	var lastIndex uint64
+	hasIndex := false
+	if indexParam := queryParams.Get(constants.WatchIndexQueryParam); indexParam != "" {
+		parsed, err := strconv.ParseUint(indexParam, 10, 64)
+		if err != nil {
+			writeErrorResponse(lc, w, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid index", err))
+			return
+		}
+		lastIndex = parsed
+		hasIndex = true
+	}
+
+	currentIndex := c.blockUntilChanged(r, registry, authorizer, token, hasIndex, lastIndex, wait, servicePrefix)
+
+	registrationModels, edgeXerr := dbClient.Registrations()
+	if edgeXerr != nil {
+		writeErrorResponse(lc, w, "", edgeXerr)
+		return
+	}
+	registrationModels = authorizer.FilterReadable(token, registrationModels)
+
+	response := responses.NewMultiRegistrationsResponse("", "", http.StatusOK, uint32(len(registrationModels)), toRegistrationDTOs(registrationModels, servicePrefix))
+	w.Header().Set(constants.RegistryIndexHeader, strconv.FormatUint(currentIndex, 10))
+	writeResponse(lc, w, http.StatusOK, response)
+}
+
+// blockUntilChanged waits until the registry's index has advanced past lastIndex with a matching,
+// readable event, wait elapses, or the request is cancelled, whichever happens first. It returns
+// the index the caller should report back to the client.
+func (c *RegistryController) blockUntilChanged(r *http.Request, registry interfaces.Registry, authorizer *acl.Authorizer, token string, hasIndex bool, lastIndex uint64, wait time.Duration, servicePrefix string) uint64 {
+	events := make(chan models.Event, 16)
+	subscribedIndex := registry.Subscribe(events)
+	defer registry.Unsubscribe(events)
+
+	if !hasIndex || lastIndex < subscribedIndex {
+		return subscribedIndex
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	for {
+		select {
+		case event := <-events:
+			if matchesWatch(event, servicePrefix) && authorizer.CanRead(token, event.Registration.ServiceId) {
+				return event.Index
+			}
+		case <-timer.C:
+			return registry.Index()
+		case <-r.Context().Done():
+			return registry.Index()
+		}
+	}
+}
+
+// watchSSE streams registry Events as they occur using the text/event-stream protocol, omitting
+// events for serviceIds token is not authorized to read.
+func (c *RegistryController) watchSSE(w http.ResponseWriter, r *http.Request, registry interfaces.Registry, authorizer *acl.Authorizer, token string, servicePrefix string) {
+	lc := bootstrapContainer.LoggingClientFrom(c.dic.Get)
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeErrorResponse(lc, w, "", errors.NewCommonEdgeX(errors.KindServerError, "streaming not supported by response writer", nil))
+		return
+	}
+
+	events := make(chan models.Event, 16)
+	registry.Subscribe(events)
+	defer registry.Unsubscribe(events)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case event := <-events:
+			if !matchesWatch(event, servicePrefix) || !authorizer.CanRead(token, event.Registration.ServiceId) {
+				continue
+			}
+			payload, err := json.Marshal(dtos.FromRegistrationModelToDTO(event.Registration))
+			if err != nil {
+				lc.Errorf("failed to encode watch event: %v", err)
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, payload); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// matchesWatch reports whether event's serviceId begins with servicePrefix, or servicePrefix is
+// empty.
+func matchesWatch(event models.Event, servicePrefix string) bool {
+	return servicePrefix == "" || strings.HasPrefix(event.Registration.ServiceId, servicePrefix)
+}
+
+// toRegistrationDTOs converts registrations to their DTO form, optionally keeping only the ones
+// whose serviceId begins with servicePrefix.
+func toRegistrationDTOs(registrationModels []models.Registration, servicePrefix string) []dtos.Registration {
+	registrationDTOs := make([]dtos.Registration, 0, len(registrationModels))
+	for _, m := range registrationModels {
+		if servicePrefix != "" && !strings.HasPrefix(m.ServiceId, servicePrefix) {
+			continue
+		}
+		registrationDTOs = append(registrationDTOs, dtos.FromRegistrationModelToDTO(m))
+	}
+	return registrationDTOs
+}
+
+// registrationMatchesFilter reports whether registrationModel satisfies every non-empty
+// constraint: it must carry all of tags, match status exactly (if given), and have a Metadata
+// entry equal to each key/value pair in metadata. An omitted constraint always matches.
+func registrationMatchesFilter(registrationModel models.Registration, tags []string, status string, metadata map[string]string) bool {
+	if status != "" && string(registrationModel.Status) != status {
+		return false
+	}
+	for _, tag := range tags {
+		if !containsTag(registrationModel.Tags, tag) {
+			return false
+		}
+	}
+	for key, value := range metadata {
+		if registrationModel.Metadata[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// containsTag reports whether tags contains target.
+func containsTag(tags []string, target string) bool {
+	for _, tag := range tags {
+		if tag == target {
+			return true
+		}
+	}
+	return false
+}
+
+// metadataFilterFromQuery extracts the ?metadata.key=value query parameters into a map keyed by
+// the part of the query key following the "metadata." prefix.
+func metadataFilterFromQuery(queryParams url.Values) map[string]string {
+	metadata := make(map[string]string)
+	for key, values := range queryParams {
+		if len(values) == 0 || !strings.HasPrefix(key, constants.MetadataQueryParamPrefix) {
+			continue
+		}
+		metadata[strings.TrimPrefix(key, constants.MetadataQueryParamPrefix)] = values[0]
+	}
+	return metadata
+}
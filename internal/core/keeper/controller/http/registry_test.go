@@ -6,11 +6,14 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	bootstrapContainer "github.com/edgexfoundry/go-mod-bootstrap/v2/bootstrap/container"
 	bootstrapConfig "github.com/edgexfoundry/go-mod-bootstrap/v2/config"
@@ -23,8 +26,12 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application/acl"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application/registry"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/config"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
 	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
@@ -52,6 +59,9 @@ func mockDic() *di.Container {
 		bootstrapContainer.LoggingClientInterfaceName: func(get di.Get) interface{} {
 			return logger.NewMockClient()
 		},
+		container.ACLAuthorizerName: func(get di.Get) interface{} {
+			return acl.NewAuthorizer(nil, "", "", false)
+		},
 	})
 }
 
@@ -157,7 +167,6 @@ func TestRegistryController_UpdateRegister(t *testing.T) {
 	dbClientMock.On("UpdateRegistration", dtos.ToRegistrationModel(notFoundServiceId.Registration)).Return(errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil))
 	registryMock := &mocks.Registry{}
 	registryMock.On("Register", validRegistrationModel)
-	registryMock.On("DeregisterByServiceId", validReq.Registration.ServiceId)
 	dic.Update(di.ServiceConstructorMap{
 		container.DBClientInterfaceName: func(get di.Get) interface{} {
 			return dbClientMock
@@ -198,6 +207,7 @@ func TestRegistryController_UpdateRegister(t *testing.T) {
 			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
 			if testCase.expectedStatusCode == http.StatusNoContent {
 				registryMock.AssertNumberOfCalls(t, "Register", 1)
+				registryMock.AssertNotCalled(t, "DeregisterByServiceId", mock.Anything)
 			}
 		})
 	}
@@ -343,3 +353,705 @@ func TestRegistryController_Registrations(t *testing.T) {
 	assert.Equal(t, 1, len(res.Registrations), "Device count not as expected")
 	assert.Empty(t, res.Message, "Message should be empty when it is successful")
 }
+
+func dicWithRegistry(dbClientMock *mocks.DBClient, realRegistry *registry.Registry) *di.Container {
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.RegistryInterfaceName: func(get di.Get) interface{} {
+			return realRegistry
+		},
+	})
+	return dic
+}
+
+func TestRegistryController_Watch_IndexProgression(t *testing.T) {
+	validRegistrationModel := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("Registrations").Return([]models.Registration{validRegistrationModel}, nil)
+	realRegistry := registry.NewRegistry()
+	dic := dicWithRegistry(dbClientMock, realRegistry)
+	controller := NewRegistryController(dic)
+
+	// an unconditional request (no ?index=) must return immediately with the current index
+	req, err := http.NewRequest(http.MethodGet, constants.ApiRegistryWatchRoute, http.NoBody)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	controller.Watch(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	firstIndex := recorder.Result().Header.Get(constants.RegistryIndexHeader)
+	assert.Equal(t, "0", firstIndex)
+
+	// register a change in the background, then confirm a blocking request with ?index=0
+	// returns as soon as it is published, with an advanced index
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		realRegistry.Register(validRegistrationModel)
+	}()
+
+	query := url.Values{}
+	query.Set(constants.WatchIndexQueryParam, "0")
+	query.Set(constants.WatchWaitQueryParam, "1s")
+	req, err = http.NewRequest(http.MethodGet, constants.ApiRegistryWatchRoute+"?"+query.Encode(), http.NoBody)
+	require.NoError(t, err)
+	recorder = httptest.NewRecorder()
+	controller.Watch(recorder, req)
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	assert.Equal(t, "1", recorder.Result().Header.Get(constants.RegistryIndexHeader))
+}
+
+// TestRegistryController_BlockUntilChanged_UsesSubscribeIndex guards against a regression where
+// registry.Index() was read separately from, and before, registry.Subscribe(): a change
+// published in the gap between those two calls would be missed by the new subscriber, forcing
+// the caller to wait out the full timeout instead of returning immediately. blockUntilChanged
+// must rely solely on the index Subscribe itself returns to decide whether the caller is already
+// caught up.
+func TestRegistryController_BlockUntilChanged_UsesSubscribeIndex(t *testing.T) {
+	registryMock := &mocks.Registry{}
+	registryMock.On("Subscribe", mock.Anything).Return(uint64(5))
+	registryMock.On("Unsubscribe", mock.Anything)
+	authorizer := acl.NewAuthorizer(nil, "", "", false)
+	controller := &RegistryController{}
+
+	req, err := http.NewRequest(http.MethodGet, constants.ApiRegistryWatchRoute, http.NoBody)
+	require.NoError(t, err)
+
+	index := controller.blockUntilChanged(req, registryMock, authorizer, "", true, 3, time.Second, "")
+
+	assert.Equal(t, uint64(5), index)
+	registryMock.AssertNotCalled(t, "Index")
+}
+
+func TestRegistryController_Watch_Timeout(t *testing.T) {
+	validRegistrationModel := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("Registrations").Return([]models.Registration{validRegistrationModel}, nil)
+	realRegistry := registry.NewRegistry()
+	dic := dicWithRegistry(dbClientMock, realRegistry)
+	controller := NewRegistryController(dic)
+
+	query := url.Values{}
+	query.Set(constants.WatchIndexQueryParam, "0")
+	query.Set(constants.WatchWaitQueryParam, "50ms")
+	req, err := http.NewRequest(http.MethodGet, constants.ApiRegistryWatchRoute+"?"+query.Encode(), http.NoBody)
+	require.NoError(t, err)
+
+	start := time.Now()
+	recorder := httptest.NewRecorder()
+	controller.Watch(recorder, req)
+	elapsed := time.Since(start)
+
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	assert.Equal(t, "0", recorder.Result().Header.Get(constants.RegistryIndexHeader))
+	assert.GreaterOrEqual(t, elapsed, 50*time.Millisecond)
+}
+
+func TestRegistryController_Watch_FilteredByServicePrefix(t *testing.T) {
+	validRegistrationModel := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	otherServiceModel := validRegistrationModel
+	otherServiceModel.ServiceId = "other-service"
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("Registrations").Return([]models.Registration{validRegistrationModel}, nil)
+	realRegistry := registry.NewRegistry()
+	dic := dicWithRegistry(dbClientMock, realRegistry)
+	controller := NewRegistryController(dic)
+
+	// a change to a serviceId outside of the requested prefix must not unblock the watch before
+	// the timeout elapses
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		realRegistry.Register(otherServiceModel)
+	}()
+
+	query := url.Values{}
+	query.Set(constants.WatchIndexQueryParam, "0")
+	query.Set(constants.WatchWaitQueryParam, "50ms")
+	query.Set(constants.WatchServiceIdPrefixQueryParam, "test-")
+	req, err := http.NewRequest(http.MethodGet, constants.ApiRegistryWatchRoute+"?"+query.Encode(), http.NoBody)
+	require.NoError(t, err)
+
+	start := time.Now()
+	recorder := httptest.NewRecorder()
+	controller.Watch(recorder, req)
+	elapsed := time.Since(start)
+
+	var res responses.MultiRegistrationsResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+	assert.GreaterOrEqual(t, elapsed, 50*time.Millisecond)
+	assert.Equal(t, 1, len(res.Registrations))
+	assert.Equal(t, testServiceId, res.Registrations[0].ServiceId)
+}
+
+func TestRegistryController_Watch_SSE(t *testing.T) {
+	validRegistrationModel := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	dbClientMock := &mocks.DBClient{}
+	realRegistry := registry.NewRegistry()
+	dic := dicWithRegistry(dbClientMock, realRegistry)
+	controller := NewRegistryController(dic)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequest(http.MethodGet, constants.ApiRegistryWatchRoute, http.NoBody)
+	require.NoError(t, err)
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "text/event-stream")
+
+	recorder := httptest.NewRecorder()
+	done := make(chan struct{})
+	go func() {
+		controller.Watch(recorder, req)
+		close(done)
+	}()
+
+	// give watchSSE a moment to subscribe before publishing, so the event isn't missed
+	time.Sleep(10 * time.Millisecond)
+	realRegistry.Register(validRegistrationModel)
+
+	require.Eventually(t, func() bool {
+		return strings.Contains(recorder.Body.String(), "event: add")
+	}, time.Second, 10*time.Millisecond, "expected a flushed SSE event for the registration")
+
+	cancel()
+	<-done
+
+	assert.Equal(t, "text/event-stream", recorder.Result().Header.Get("Content-Type"))
+	assert.Contains(t, recorder.Body.String(), `"serviceId":"`+testServiceId+`"`)
+}
+
+func TestRegistryController_Register_HealthCheckTypeValidation(t *testing.T) {
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	registryMock := &mocks.Registry{}
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.RegistryInterfaceName: func(get di.Get) interface{} {
+			return registryMock
+		},
+		// script checks must be enabled for the scriptArgs case to reach its own validation
+		container.ConfigurationName: func(get di.Get) interface{} {
+			return &config.ConfigurationStruct{Writable: config.WritableInfo{EnableScriptHealthChecks: true}}
+		},
+	})
+	controller := NewRegistryController(dic)
+
+	missingTCPAddress := buildTestRegistrationRequest()
+	missingTCPAddress.Registration.HealthCheck = dtos.HealthCheck{Interval: "10s", Type: "tcp"}
+
+	missingGRPCService := buildTestRegistrationRequest()
+	missingGRPCService.Registration.HealthCheck = dtos.HealthCheck{Interval: "10s", Type: "grpc"}
+
+	invalidTTL := buildTestRegistrationRequest()
+	invalidTTL.Registration.HealthCheck = dtos.HealthCheck{Interval: "10s", Type: "ttl", TTL: "not-a-duration"}
+
+	missingScriptArgs := buildTestRegistrationRequest()
+	missingScriptArgs.Registration.HealthCheck = dtos.HealthCheck{Interval: "10s", Type: "script"}
+
+	unsupportedType := buildTestRegistrationRequest()
+	unsupportedType.Registration.HealthCheck = dtos.HealthCheck{Interval: "10s", Type: "websocket"}
+
+	tests := []struct {
+		name    string
+		request requests.AddRegistrationRequest
+	}{
+		{"invalid - grpc without grpcService", missingGRPCService},
+		{"invalid - ttl with malformed duration", invalidTTL},
+		{"invalid - script without scriptArgs", missingScriptArgs},
+		{"invalid - unsupported health check type", unsupportedType},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal(testCase.request)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, constants.ApiRegisterRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			controller.Register(recorder, req)
+
+			assert.Equal(t, http.StatusBadRequest, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+
+	// a "tcp" check without a tcpAddress is valid - Host:Port is used instead
+	tcpRegistrationModel := dtos.ToRegistrationModel(missingTCPAddress.Registration)
+	tcpRegistrationModel.Status = v2Models.Unknown
+	dbClientMock.On("AddRegistration", tcpRegistrationModel).Return(tcpRegistrationModel, nil)
+	registryMock.On("Register", tcpRegistrationModel)
+
+	jsonData, err := json.Marshal(missingTCPAddress)
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodPost, constants.ApiRegisterRoute, strings.NewReader(string(jsonData)))
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	controller.Register(recorder, req)
+	assert.Equal(t, http.StatusCreated, recorder.Result().StatusCode, "HTTP status code not as expected")
+}
+
+func TestRegistryController_CheckTTL(t *testing.T) {
+	ttlRegistrationModel := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	ttlRegistrationModel.HealthCheck = models.HealthCheck{Interval: "10s", Type: "ttl", TTL: "30s"}
+	ttlRegistrationModel.Status = v2Models.Unknown
+
+	httpRegistrationModel := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	httpRegistrationModel.ServiceId = "http-service"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("RegistrationByServiceId", testServiceId).Return(ttlRegistrationModel, nil)
+	dbClientMock.On("RegistrationByServiceId", httpRegistrationModel.ServiceId).Return(httpRegistrationModel, nil)
+	updatedModel := ttlRegistrationModel
+	updatedModel.Status = v2Models.Up
+	dbClientMock.On("UpdateRegistration", updatedModel).Return(nil)
+	registryMock := &mocks.Registry{}
+	registryMock.On("Register", updatedModel)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.RegistryInterfaceName: func(get di.Get) interface{} {
+			return registryMock
+		},
+		container.TTLTrackerName: func(get di.Get) interface{} {
+			return application.NewTTLTracker()
+		},
+	})
+	controller := NewRegistryController(dic)
+
+	tests := []struct {
+		name               string
+		serviceId          string
+		status             string
+		expectedStatusCode int
+	}{
+		{"valid - pass", testServiceId, "pass", http.StatusNoContent},
+		{"invalid - unsupported status", testServiceId, "critical", http.StatusBadRequest},
+		{"invalid - not a ttl check", httpRegistrationModel.ServiceId, "pass", http.StatusBadRequest},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPut, constants.ApiCheckTTLRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{constants.ServiceId: testCase.serviceId, constants.CheckStatus: testCase.status})
+
+			recorder := httptest.NewRecorder()
+			controller.CheckTTL(recorder, req)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+}
+
+func TestRegistryController_Registrations_Filtering(t *testing.T) {
+	primary := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	primary.Status = v2Models.Up
+	primary.Tags = []string{"primary", "edge"}
+	primary.Metadata = map[string]string{"region": "us-east"}
+
+	secondary := primary
+	secondary.ServiceId = "test-service-2"
+	secondary.Status = v2Models.Down
+	secondary.Tags = []string{"edge"}
+	secondary.Metadata = map[string]string{"region": "us-west"}
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("Registrations").Return([]models.Registration{primary, secondary}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewRegistryController(dic)
+
+	tests := []struct {
+		name              string
+		rawQuery          string
+		expectedServiceId []string
+	}{
+		{"no filter", "", []string{primary.ServiceId, secondary.ServiceId}},
+		{"tag filter", "tag=primary", []string{primary.ServiceId}},
+		{"status filter", "status=" + string(v2Models.Down), []string{secondary.ServiceId}},
+		{"metadata filter", "metadata.region=us-west", []string{secondary.ServiceId}},
+		{"combined filters with no match", "tag=primary&status=" + string(v2Models.Down), []string{}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, constants.ApiAllRegistrationsRoute+"?"+testCase.rawQuery, http.NoBody)
+			require.NoError(t, err)
+
+			recorder := httptest.NewRecorder()
+			controller.Registrations(recorder, req)
+
+			var res responses.MultiRegistrationsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, len(testCase.expectedServiceId), len(res.Registrations), "filtered registration count not as expected")
+			for _, serviceId := range testCase.expectedServiceId {
+				found := false
+				for _, registration := range res.Registrations {
+					if registration.ServiceId == serviceId {
+						found = true
+						break
+					}
+				}
+				assert.True(t, found, "expected serviceId %s in filtered result", serviceId)
+			}
+		})
+	}
+}
+
+func TestRegistryController_DNSQuery(t *testing.T) {
+	up := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	up.ServiceId = "test-service"
+	up.Status = v2Models.Up
+	up.Tags = []string{"primary"}
+
+	down := up
+	down.Status = v2Models.Down
+
+	unrelated := up
+	unrelated.ServiceId = "other-service"
+
+	// prefixMatch only begins with the queried serviceId; it must not be returned now that
+	// DNSQuery matches exactly, unlike Watch's opt-in servicePrefix filter.
+	prefixMatch := up
+	prefixMatch.ServiceId = "test-service-2"
+
+	dic := mockDic()
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("Registrations").Return([]models.Registration{up, down, unrelated, prefixMatch}, nil)
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+	})
+	controller := NewRegistryController(dic)
+
+	tests := []struct {
+		name          string
+		serviceId     string
+		rawQuery      string
+		expectedCount int
+	}{
+		{"valid - only up instances returned, prefix-sharing serviceIds excluded", "test-service", "", 1},
+		{"valid - matching tag", "test-service", "tag=primary", 1},
+		{"invalid - no matching tag", "test-service", "tag=secondary", 0},
+		{"invalid - empty serviceId", "", "", 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, constants.ApiRegistryDNSRoute+"?"+testCase.rawQuery, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{constants.ServiceId: testCase.serviceId})
+
+			recorder := httptest.NewRecorder()
+			controller.DNSQuery(recorder, req)
+
+			if testCase.serviceId == "" {
+				assert.Equal(t, http.StatusBadRequest, recorder.Result().StatusCode, "HTTP status code not as expected")
+				return
+			}
+
+			var res responses.MultiSRVRecordsResponse
+			err = json.Unmarshal(recorder.Body.Bytes(), &res)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedCount, len(res.Records), "SRV record count not as expected")
+		})
+	}
+}
+
+// dicWithACL returns a dic configured like mockDic but with ACLs enabled and a token store that
+// grants writerToken write+read on the "device-" prefix and readerToken read-only everywhere.
+func dicWithACL(dbClientMock *mocks.DBClient) (*di.Container, string, string) {
+	const writerToken = "writer-token"
+	const readerToken = "reader-token"
+
+	tokenStore := acl.NewInMemoryTokenStore()
+	tokenStore.SetPolicies(writerToken, []models.ACLPolicy{
+		{ServicePattern: "device-", Capabilities: []string{constants.ACLCapabilityWrite, constants.ACLCapabilityRead}},
+	})
+	tokenStore.SetPolicies(readerToken, []models.ACLPolicy{
+		{ServicePattern: "", Capabilities: []string{constants.ACLCapabilityRead}},
+	})
+	authorizer := acl.NewAuthorizer(tokenStore, "", constants.ACLDefaultPolicyDeny, true)
+
+	dic := mockDic()
+	dic.Update(di.ServiceConstructorMap{
+		container.DBClientInterfaceName: func(get di.Get) interface{} {
+			return dbClientMock
+		},
+		container.ACLAuthorizerName: func(get di.Get) interface{} {
+			return authorizer
+		},
+	})
+	return dic, writerToken, readerToken
+}
+
+func TestRegistryController_Register_ACLDeniedWrite(t *testing.T) {
+	req := buildTestRegistrationRequest()
+	req.Registration.ServiceId = "sensor-1"
+	dbClientMock := &mocks.DBClient{}
+	registryMock := &mocks.Registry{}
+	dic, writerToken, readerToken := dicWithACL(dbClientMock)
+	dic.Update(di.ServiceConstructorMap{
+		container.RegistryInterfaceName: func(get di.Get) interface{} {
+			return registryMock
+		},
+	})
+	controller := NewRegistryController(dic)
+
+	tests := []struct {
+		name               string
+		token              string
+		expectedStatusCode int
+	}{
+		{"denied - read-only token", readerToken, http.StatusForbidden},
+		{"denied - missing token", "", http.StatusForbidden},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			jsonData, err := json.Marshal(req)
+			require.NoError(t, err)
+
+			httpReq, err := http.NewRequest(http.MethodPost, constants.ApiRegisterRoute, strings.NewReader(string(jsonData)))
+			require.NoError(t, err)
+			httpReq.Header.Set(constants.ACLTokenHeader, testCase.token)
+
+			recorder := httptest.NewRecorder()
+			controller.Register(recorder, httpReq)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+
+	// sanity check: the token whose policy matches the target serviceId is still allowed through
+	deviceReq := buildTestRegistrationRequest()
+	deviceReq.Registration.ServiceId = "device-1"
+	deviceModel := dtos.ToRegistrationModel(deviceReq.Registration)
+	deviceModel.Status = v2Models.Unknown
+	dbClientMock.On("AddRegistration", deviceModel).Return(deviceModel, nil)
+	registryMock.On("Register", deviceModel)
+
+	jsonData, err := json.Marshal(deviceReq)
+	require.NoError(t, err)
+	httpReq, err := http.NewRequest(http.MethodPost, constants.ApiRegisterRoute, strings.NewReader(string(jsonData)))
+	require.NoError(t, err)
+	httpReq.Header.Set(constants.ACLTokenHeader, writerToken)
+
+	recorder := httptest.NewRecorder()
+	controller.Register(recorder, httpReq)
+	assert.Equal(t, http.StatusCreated, recorder.Result().StatusCode, "HTTP status code not as expected")
+}
+
+func TestRegistryController_RegistrationByServiceId_ACLFiltersUnreadable(t *testing.T) {
+	sensorModel := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	sensorModel.ServiceId = "sensor-1"
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("RegistrationByServiceId", sensorModel.ServiceId).Return(sensorModel, nil)
+	dic, _, readerToken := dicWithACL(dbClientMock)
+	controller := NewRegistryController(dic)
+
+	req, err := http.NewRequest(http.MethodGet, constants.ApiRegistrationByServiceIdRoute, http.NoBody)
+	require.NoError(t, err)
+	req = mux.SetURLVars(req, map[string]string{constants.ServiceId: sensorModel.ServiceId})
+	req.Header.Set(constants.ACLTokenHeader, readerToken)
+
+	recorder := httptest.NewRecorder()
+	controller.RegistrationByServiceId(recorder, req)
+
+	// readerToken can only read serviceIds, sensor-1 included, since its policy's ServicePattern
+	// is empty; swap to a token with no policies to exercise the "not found" path.
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+
+	req.Header.Set(constants.ACLTokenHeader, "unknown-token")
+	recorder = httptest.NewRecorder()
+	controller.RegistrationByServiceId(recorder, req)
+	assert.Equal(t, http.StatusNotFound, recorder.Result().StatusCode, "unauthorized read should be reported as not found")
+}
+
+func TestRegistryController_Registrations_ACLFiltersList(t *testing.T) {
+	deviceModel := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	deviceModel.ServiceId = "device-1"
+	sensorModel := deviceModel
+	sensorModel.ServiceId = "sensor-1"
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("Registrations").Return([]models.Registration{deviceModel, sensorModel}, nil)
+	dic, writerToken, _ := dicWithACL(dbClientMock)
+	controller := NewRegistryController(dic)
+
+	req, err := http.NewRequest(http.MethodGet, constants.ApiAllRegistrationsRoute, http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set(constants.ACLTokenHeader, writerToken)
+
+	recorder := httptest.NewRecorder()
+	controller.Registrations(recorder, req)
+
+	var res responses.MultiRegistrationsResponse
+	err = json.Unmarshal(recorder.Body.Bytes(), &res)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, 1, len(res.Registrations), "only the device- prefixed registration should be visible to writerToken")
+	assert.Equal(t, deviceModel.ServiceId, res.Registrations[0].ServiceId)
+}
+
+func TestRegistryController_Deregister_ACLMiddleware(t *testing.T) {
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("DeleteRegistrationByServiceId", "device-1").Return(nil)
+	registryMock := &mocks.Registry{}
+	registryMock.On("DeregisterByServiceId", "device-1")
+	dic, writerToken, readerToken := dicWithACL(dbClientMock)
+	dic.Update(di.ServiceConstructorMap{
+		container.RegistryInterfaceName: func(get di.Get) interface{} {
+			return registryMock
+		},
+	})
+	controller := NewRegistryController(dic)
+	authorizer := container.ACLAuthorizerFrom(dic.Get)
+	serviceIdFromVars := func(req *http.Request) string { return mux.Vars(req)[constants.ServiceId] }
+	handler := acl.RequireCapability(authorizer, constants.ACLCapabilityWrite, serviceIdFromVars, controller.Deregister)
+
+	tests := []struct {
+		name               string
+		token              string
+		expectedStatusCode int
+	}{
+		{"denied - read-only token", readerToken, http.StatusForbidden},
+		{"allowed - matching write policy", writerToken, http.StatusNoContent},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodDelete, constants.ApiRegistrationByServiceIdRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{constants.ServiceId: "device-1"})
+			req.Header.Set(constants.ACLTokenHeader, testCase.token)
+
+			recorder := httptest.NewRecorder()
+			handler(recorder, req)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+}
+
+func TestRegistryController_CheckTTL_ACLDeniedWrite(t *testing.T) {
+	ttlRegistrationModel := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	ttlRegistrationModel.ServiceId = "device-1"
+	ttlRegistrationModel.HealthCheck = models.HealthCheck{Interval: "10s", Type: "ttl", TTL: "30s"}
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("RegistrationByServiceId", ttlRegistrationModel.ServiceId).Return(ttlRegistrationModel, nil)
+	updatedModel := ttlRegistrationModel
+	updatedModel.Status = v2Models.Up
+	dbClientMock.On("UpdateRegistration", updatedModel).Return(nil)
+	registryMock := &mocks.Registry{}
+	registryMock.On("Register", updatedModel)
+	dic, writerToken, readerToken := dicWithACL(dbClientMock)
+	dic.Update(di.ServiceConstructorMap{
+		container.RegistryInterfaceName: func(get di.Get) interface{} {
+			return registryMock
+		},
+		container.TTLTrackerName: func(get di.Get) interface{} {
+			return application.NewTTLTracker()
+		},
+	})
+	controller := NewRegistryController(dic)
+
+	tests := []struct {
+		name               string
+		token              string
+		expectedStatusCode int
+	}{
+		{"denied - read-only token", readerToken, http.StatusForbidden},
+		{"allowed - matching write policy", writerToken, http.StatusNoContent},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodPut, constants.ApiCheckTTLRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{constants.ServiceId: ttlRegistrationModel.ServiceId, constants.CheckStatus: "pass"})
+			req.Header.Set(constants.ACLTokenHeader, testCase.token)
+
+			recorder := httptest.NewRecorder()
+			controller.CheckTTL(recorder, req)
+
+			assert.Equal(t, testCase.expectedStatusCode, recorder.Result().StatusCode, "HTTP status code not as expected")
+		})
+	}
+}
+
+func TestRegistryController_DNSQuery_ACLFiltersUnreadable(t *testing.T) {
+	deviceModel := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	deviceModel.ServiceId = "device-1"
+	deviceModel.Status = v2Models.Up
+	sensorModel := deviceModel
+	sensorModel.ServiceId = "sensor-1"
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("Registrations").Return([]models.Registration{deviceModel, sensorModel}, nil)
+	dic, writerToken, _ := dicWithACL(dbClientMock)
+	controller := NewRegistryController(dic)
+
+	tests := []struct {
+		name          string
+		serviceId     string
+		expectedCount int
+	}{
+		{"readable serviceId returns a record", deviceModel.ServiceId, 1},
+		{"unreadable serviceId is filtered out before matching", sensorModel.ServiceId, 0},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, constants.ApiRegistryDNSRoute, http.NoBody)
+			require.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{constants.ServiceId: testCase.serviceId})
+			req.Header.Set(constants.ACLTokenHeader, writerToken)
+
+			recorder := httptest.NewRecorder()
+			controller.DNSQuery(recorder, req)
+
+			var res responses.MultiSRVRecordsResponse
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+			assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+			assert.Equal(t, testCase.expectedCount, len(res.Records), "SRV record count not as expected")
+		})
+	}
+}
+
+func TestRegistryController_Watch_ACLFiltersUnreadable(t *testing.T) {
+	deviceModel := dtos.ToRegistrationModel(buildTestRegistrationRequest().Registration)
+	deviceModel.ServiceId = "device-1"
+	sensorModel := deviceModel
+	sensorModel.ServiceId = "sensor-1"
+
+	dbClientMock := &mocks.DBClient{}
+	dbClientMock.On("Registrations").Return([]models.Registration{deviceModel, sensorModel}, nil)
+	realRegistry := registry.NewRegistry()
+	dic, writerToken, _ := dicWithACL(dbClientMock)
+	dic.Update(di.ServiceConstructorMap{
+		container.RegistryInterfaceName: func(get di.Get) interface{} {
+			return realRegistry
+		},
+	})
+	controller := NewRegistryController(dic)
+
+	req, err := http.NewRequest(http.MethodGet, constants.ApiRegistryWatchRoute, http.NoBody)
+	require.NoError(t, err)
+	req.Header.Set(constants.ACLTokenHeader, writerToken)
+
+	recorder := httptest.NewRecorder()
+	controller.Watch(recorder, req)
+
+	var res responses.MultiRegistrationsResponse
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &res))
+	assert.Equal(t, http.StatusOK, recorder.Result().StatusCode, "HTTP status code not as expected")
+	assert.Equal(t, 1, len(res.Registrations), "only the device- prefixed registration should be visible to writerToken")
+	assert.Equal(t, deviceModel.ServiceId, res.Registrations[0].ServiceId)
+}
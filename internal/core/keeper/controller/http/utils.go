@@ -0,0 +1,50 @@
+//
+// Copyright (C) 2022-2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/clients/logger"
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// writeResponse marshals v as JSON to the response writer and sets the status code, logging any
+// encoding failure rather than returning it since headers have already been written at this point.
+func writeResponse(lc logger.LoggingClient, w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		lc.Errorf("failed to encode response: %v", err)
+	}
+}
+
+// writeErrorResponse logs err and writes a commonDTO.BaseResponse describing it.
+func writeErrorResponse(lc logger.LoggingClient, w http.ResponseWriter, requestId string, err errors.EdgeX) {
+	lc.Error(err.Error())
+	statusCode := httpStatusCodeForKind(errors.Kind(err))
+	response := commonDTO.NewBaseResponse(requestId, err.Message(), statusCode)
+	writeResponse(lc, w, statusCode, response)
+}
+
+// httpStatusCodeForKind maps an errors.Kind to the HTTP status code the controllers should
+// return for it.
+func httpStatusCodeForKind(kind errors.Kind) int {
+	switch kind {
+	case errors.KindContractInvalid, errors.KindInvalidId:
+		return http.StatusBadRequest
+	case errors.KindEntityDoesNotExist, errors.KindNotFound:
+		return http.StatusNotFound
+	case errors.KindDuplicateName:
+		return http.StatusConflict
+	case errors.KindNotAllowed:
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
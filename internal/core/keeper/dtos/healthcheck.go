@@ -0,0 +1,35 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// HealthCheck is the DTO representing how a registered service's health should be probed. Only
+// the fields relevant to Type need to be populated; see constants.HealthCheckType* for the
+// supported types.
+type HealthCheck struct {
+	Interval string `json:"interval" validate:"required"`
+	Type     string `json:"type" validate:"required,oneof='http' 'tcp' 'grpc' 'ttl' 'script'"`
+
+	// Path is required when Type is "http".
+	Path string `json:"path,omitempty"`
+
+	// TCPAddress is optional when Type is "tcp"; Host:Port of the Registration is used if empty.
+	TCPAddress string `json:"tcpAddress,omitempty"`
+
+	// GRPCService and TLS configure a "grpc" check, which calls the standard gRPC health checking
+	// protocol service.
+	GRPCService string `json:"grpcService,omitempty"`
+	TLS         bool   `json:"tls,omitempty"`
+
+	// TTL is required when Type is "ttl"; the check is marked Down once this duration passes
+	// after the last heartbeat received via the check/ttl endpoint. Until the first heartbeat
+	// arrives the status stays Unknown.
+	TTL string `json:"ttl,omitempty"`
+
+	// ScriptArgs is required when Type is "script": ScriptArgs[0] is executed with the remaining
+	// entries as arguments, and the check fails unless it exits zero before ScriptDeadline elapses.
+	ScriptArgs     []string `json:"scriptArgs,omitempty"`
+	ScriptDeadline string   `json:"scriptDeadline,omitempty"`
+}
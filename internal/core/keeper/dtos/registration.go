@@ -0,0 +1,69 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	v2Models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// Registration is the DTO used to register, update and return a service instance known to core-keeper.
+type Registration struct {
+	ServiceId   string                      `json:"serviceId" validate:"required,edgex-dto-none-empty-string"`
+	Host        string                      `json:"host" validate:"required"`
+	Port        int                         `json:"port" validate:"required,gt=0"`
+	HealthCheck HealthCheck                 `json:"healthCheck"`
+	Status      v2Models.RegistrationStatus `json:"status,omitempty"`
+	Tags        []string                    `json:"tags,omitempty"`
+	Metadata    map[string]string           `json:"metadata,omitempty"`
+}
+
+// ToRegistrationModel transforms the Registration DTO to the Registration model.
+func ToRegistrationModel(dto Registration) models.Registration {
+	return models.Registration{
+		ServiceId: dto.ServiceId,
+		Host:      dto.Host,
+		Port:      dto.Port,
+		HealthCheck: models.HealthCheck{
+			Interval:       dto.HealthCheck.Interval,
+			Type:           dto.HealthCheck.Type,
+			Path:           dto.HealthCheck.Path,
+			TCPAddress:     dto.HealthCheck.TCPAddress,
+			GRPCService:    dto.HealthCheck.GRPCService,
+			TLS:            dto.HealthCheck.TLS,
+			TTL:            dto.HealthCheck.TTL,
+			ScriptArgs:     dto.HealthCheck.ScriptArgs,
+			ScriptDeadline: dto.HealthCheck.ScriptDeadline,
+		},
+		Status:   dto.Status,
+		Tags:     dto.Tags,
+		Metadata: dto.Metadata,
+	}
+}
+
+// FromRegistrationModelToDTO transforms the Registration model to the Registration DTO.
+func FromRegistrationModelToDTO(model models.Registration) Registration {
+	return Registration{
+		ServiceId: model.ServiceId,
+		Host:      model.Host,
+		Port:      model.Port,
+		HealthCheck: HealthCheck{
+			Interval:       model.HealthCheck.Interval,
+			Type:           model.HealthCheck.Type,
+			Path:           model.HealthCheck.Path,
+			TCPAddress:     model.HealthCheck.TCPAddress,
+			GRPCService:    model.HealthCheck.GRPCService,
+			TLS:            model.HealthCheck.TLS,
+			TTL:            model.HealthCheck.TTL,
+			ScriptArgs:     model.HealthCheck.ScriptArgs,
+			ScriptDeadline: model.HealthCheck.ScriptDeadline,
+		},
+		Status:   model.Status,
+		Tags:     model.Tags,
+		Metadata: model.Metadata,
+	}
+}
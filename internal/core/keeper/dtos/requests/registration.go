@@ -0,0 +1,26 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+)
+
+// AddRegistrationRequest defines the request content for registering or updating a service instance.
+type AddRegistrationRequest struct {
+	commonDTO.BaseRequest `json:",inline"`
+	Registration          dtos.Registration `json:"registration"`
+}
+
+// Validate satisfies the Validator interface. It performs no checks and always returns nil; the
+// Register and UpdateRegister handlers validate the Registration with
+// application.ValidateRegistration instead.
+func (a AddRegistrationRequest) Validate() errors.EdgeX {
+	return nil
+}
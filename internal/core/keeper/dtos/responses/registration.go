@@ -0,0 +1,42 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+)
+
+// RegistrationResponse defines the response content for returning a single Registration.
+type RegistrationResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Registration           dtos.Registration `json:"registration"`
+}
+
+// NewRegistrationResponse creates a new RegistrationResponse.
+func NewRegistrationResponse(requestId string, message string, statusCode int, registration dtos.Registration) RegistrationResponse {
+	return RegistrationResponse{
+		BaseResponse: commonDTO.NewBaseResponse(requestId, message, statusCode),
+		Registration: registration,
+	}
+}
+
+// MultiRegistrationsResponse defines the response content for returning multiple Registrations.
+type MultiRegistrationsResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Registrations          []dtos.Registration `json:"registrations"`
+	TotalCount             uint32              `json:"totalCount"`
+}
+
+// NewMultiRegistrationsResponse creates a new MultiRegistrationsResponse.
+func NewMultiRegistrationsResponse(requestId string, message string, statusCode int, totalCount uint32, registrations []dtos.Registration) MultiRegistrationsResponse {
+	return MultiRegistrationsResponse{
+		BaseResponse:  commonDTO.NewBaseResponse(requestId, message, statusCode),
+		Registrations: registrations,
+		TotalCount:    totalCount,
+	}
+}
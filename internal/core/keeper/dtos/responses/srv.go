@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	commonDTO "github.com/edgexfoundry/go-mod-core-contracts/v2/dtos/common"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/dtos"
+)
+
+// MultiSRVRecordsResponse defines the response content for a DNS-SRV style registry lookup.
+type MultiSRVRecordsResponse struct {
+	commonDTO.BaseResponse `json:",inline"`
+	Records                []dtos.SRVRecord `json:"records"`
+	TotalCount             uint32           `json:"totalCount"`
+}
+
+// NewMultiSRVRecordsResponse creates a new MultiSRVRecordsResponse.
+func NewMultiSRVRecordsResponse(requestId string, message string, statusCode int, totalCount uint32, records []dtos.SRVRecord) MultiSRVRecordsResponse {
+	return MultiSRVRecordsResponse{
+		BaseResponse: commonDTO.NewBaseResponse(requestId, message, statusCode),
+		Records:      records,
+		TotalCount:   totalCount,
+	}
+}
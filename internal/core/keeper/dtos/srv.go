@@ -0,0 +1,15 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// SRVRecord is an RFC 2782-style service discovery record, as returned by the registry's DNS-SRV
+// style lookup endpoint.
+type SRVRecord struct {
+	Target   string `json:"target"`
+	Port     int    `json:"port"`
+	Weight   int    `json:"weight"`
+	Priority int    `json:"priority"`
+}
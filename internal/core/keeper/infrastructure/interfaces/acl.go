@@ -0,0 +1,17 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// ACLStore is the interface for the persistence layer backing DB-backed ACL token storage.
+type ACLStore interface {
+	ACLPoliciesByToken(token string) ([]models.ACLPolicy, errors.EdgeX)
+}
@@ -0,0 +1,21 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// DBClient is the interface for the persistence layer backing the registry.
+type DBClient interface {
+	AddRegistration(registration models.Registration) (models.Registration, errors.EdgeX)
+	UpdateRegistration(registration models.Registration) errors.EdgeX
+	DeleteRegistrationByServiceId(serviceId string) errors.EdgeX
+	RegistrationByServiceId(serviceId string) (models.Registration, errors.EdgeX)
+	Registrations() ([]models.Registration, errors.EdgeX)
+}
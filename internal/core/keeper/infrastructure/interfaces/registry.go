@@ -0,0 +1,31 @@
+//
+// Copyright (C) 2022-2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/models"
+)
+
+// Registry is the interface for the in-memory view of registered services that backs the
+// blocking-query style registry APIs. It is kept separate from DBClient because it only ever
+// reflects the current, in-memory state of the cluster and is never queried for history.
+type Registry interface {
+	// Register adds or updates the in-memory entry for the given Registration.
+	Register(registration models.Registration)
+	// DeregisterByServiceId removes the in-memory entry for the given serviceId, if present.
+	DeregisterByServiceId(serviceId string)
+
+	// Subscribe registers ch to receive registry Events as they occur and returns the registry's
+	// current index. The caller owns ch and must keep draining it; a slow consumer misses the
+	// events that arrive while ch's buffer is full.
+	Subscribe(ch chan<- models.Event) uint64
+	// Unsubscribe removes a channel previously passed to Subscribe. It is a no-op if ch is not
+	// currently subscribed.
+	Unsubscribe(ch chan<- models.Event)
+	// Index returns the current monotonic index of the registry. The index is bumped on every
+	// Register call and on every DeregisterByServiceId call that removes an entry.
+	Index() uint64
+}
@@ -0,0 +1,13 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// ACLPolicy grants a token a set of Capabilities (see constants.ACLCapability*) over every
+// serviceId beginning with ServicePattern.
+type ACLPolicy struct {
+	ServicePattern string
+	Capabilities   []string
+}
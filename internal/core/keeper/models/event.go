@@ -0,0 +1,24 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+// EventType identifies what kind of change a registry Event represents.
+type EventType string
+
+const (
+	Add    EventType = "add"
+	Update EventType = "update"
+	Delete EventType = "delete"
+)
+
+// Event describes a single change to the registry's state. Index is the monotonic registry
+// index at the time the change was applied, so subscribers can resume a watch from where they
+// left off.
+type Event struct {
+	Type         EventType
+	Registration Registration
+	Index        uint64
+}
@@ -0,0 +1,41 @@
+//
+// Copyright (C) 2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	v2Models "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+)
+
+// Registration represents a single instance of a registered service as persisted in the database.
+type Registration struct {
+	ServiceId   string
+	Host        string
+	Port        int
+	HealthCheck HealthCheck
+	Status      v2Models.RegistrationStatus
+	Tags        []string
+	Metadata    map[string]string
+}
+
+// HealthCheck represents the health check configuration associated with a Registration. Only the
+// fields relevant to Type are populated; see constants.HealthCheckType* for the supported types.
+type HealthCheck struct {
+	Interval string
+	Type     string
+
+	// Path is used by "http" checks.
+	Path string
+	// TCPAddress is used by "tcp" checks; if empty, Host:Port of the owning Registration is used.
+	TCPAddress string
+	// GRPCService and TLS are used by "grpc" checks.
+	GRPCService string
+	TLS         bool
+	// TTL is used by "ttl" checks.
+	TTL string
+	// ScriptArgs and ScriptDeadline are used by "script" checks.
+	ScriptArgs     []string
+	ScriptDeadline string
+}
@@ -0,0 +1,41 @@
+//
+// Copyright (C) 2022-2023 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-bootstrap/v2/di"
+
+	"github.com/gorilla/mux"
+
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/application/acl"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/constants"
+	"github.com/edgexfoundry/edgex-go/internal/core/keeper/container"
+	httpController "github.com/edgexfoundry/edgex-go/internal/core/keeper/controller/http"
+)
+
+// LoadRestRoutes registers the core-keeper HTTP API with the given router.
+func LoadRestRoutes(r *mux.Router, dic *di.Container) {
+	registryController := httpController.NewRegistryController(dic)
+	authorizer := container.ACLAuthorizerFrom(dic.Get)
+	serviceIdFromVars := func(req *http.Request) string { return mux.Vars(req)[constants.ServiceId] }
+
+	r.HandleFunc(constants.ApiRegisterRoute, registryController.Register).Methods(http.MethodPost)
+	r.HandleFunc(constants.ApiRegisterRoute, registryController.UpdateRegister).Methods(http.MethodPut)
+	r.HandleFunc(constants.ApiAllRegistrationsRoute, registryController.Registrations).Methods(http.MethodGet)
+	r.HandleFunc(constants.ApiRegistrationByServiceIdRoute, registryController.RegistrationByServiceId).Methods(http.MethodGet)
+	r.HandleFunc(constants.ApiRegistrationByServiceIdRoute, acl.RequireCapability(authorizer, constants.ACLCapabilityWrite, serviceIdFromVars, registryController.Deregister)).Methods(http.MethodDelete)
+	r.HandleFunc(constants.ApiRegistryWatchRoute, registryController.Watch).Methods(http.MethodGet)
+	r.HandleFunc(constants.ApiCheckTTLRoute, registryController.CheckTTL).Methods(http.MethodPut)
+	r.HandleFunc(constants.ApiRegistryDNSRoute, registryController.DNSQuery).Methods(http.MethodGet)
+
+	// Reload is not scoped to a single serviceId, so it requires a write policy matching every
+	// serviceId (i.e. ServicePattern "") rather than one scoped to whatever the caller happens to
+	// pass in.
+	wholeRegistry := func(_ *http.Request) string { return "" }
+	r.HandleFunc(constants.ApiRegistryReloadRoute, acl.RequireCapability(authorizer, constants.ACLCapabilityWrite, wholeRegistry, registryController.Reload)).Methods(http.MethodPost)
+}